@@ -29,11 +29,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -46,6 +48,7 @@ import (
 	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	tfsts "github.com/hashicorp/terraform-provider-aws/internal/service/sts"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/jmespath/go-jmespath"
 	"github.com/mitchellh/mapstructure"
 )
@@ -91,6 +94,16 @@ var (
 	ProtoV5ProviderFactories map[string]func() (tfprotov5.ProviderServer, error) = protoV5ProviderFactoriesInit(context.Background(), ProviderName)
 )
 
+// ProtoV6EchoProviderFactories is a static map containing only the echo test provider instance.
+//
+// The echo provider copies its `data` configuration argument into the state of an `echo` resource,
+// allowing tests to verify ephemeral resource values. See ConfigWithEchoProvider.
+var (
+	ProtoV6EchoProviderFactories map[string]func() (tfprotov6.ProviderServer, error) = map[string]func() (tfprotov6.ProviderServer, error){
+		"echo": echoprovider.NewProviderServer(),
+	}
+)
+
 // Provider is the "main" provider instance
 //
 // This Provider can be used in testing code for API calls without requiring
@@ -240,10 +253,13 @@ func PreCheck(ctx context.Context, t *testing.T) {
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
-		envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
+		// No credentials are needed when replaying recorded HTTP fixtures.
+		if os.Getenv(envvar.HTTPFixturesDirectory) == "" || os.Getenv(envvar.HTTPFixturesMode) == vcr.ModeRecord {
+			envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
 
-		if os.Getenv(envvar.AccessKeyId) != "" {
-			envvar.FailIfEmpty(t, envvar.SecretAccessKey, "static credentials value when using "+envvar.AccessKeyId)
+			if os.Getenv(envvar.AccessKeyId) != "" {
+				envvar.FailIfEmpty(t, envvar.SecretAccessKey, "static credentials value when using "+envvar.AccessKeyId)
+			}
 		}
 
 		// Setting the AWS_DEFAULT_REGION environment variable here allows all tests to omit
@@ -1106,6 +1122,18 @@ func PreCheckOutpostsOutposts(ctx context.Context, t *testing.T) {
 	}
 }
 
+// ConfigWithEchoProvider returns the configuration for the echo test provider,
+// passing the specified ephemeral resource value through to the `echo.test` resource.
+func ConfigWithEchoProvider(ephemeralData string) string {
+	return fmt.Sprintf(`
+provider "echo" {
+  data = %[1]s
+}
+
+resource "echo" "test" {}
+`, ephemeralData)
+}
+
 func ConfigAlternateAccountProvider() string {
 	//lintignore:AT004
 	return ConfigNamedAccountProvider(
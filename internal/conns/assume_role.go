@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// assumeRoles returns AWS SDK for Go v2 configuration whose credentials are obtained by assuming each of the specified IAM roles in turn,
+// starting with the credentials in the specified configuration.
+func (c *Config) assumeRoles(ctx context.Context, cfg aws_sdkv2.Config, roles []awsbase.AssumeRole) (aws_sdkv2.Config, error) {
+	for _, role := range roles {
+		role := role
+
+		if role.RoleARN == "" {
+			return cfg, fmt.Errorf("assuming IAM Role: role ARN not set")
+		}
+
+		tflog.Info(ctx, "Assuming IAM Role", map[string]any{
+			"tf_aws.assume_role.role_arn":        role.RoleARN,
+			"tf_aws.assume_role.session_name":    role.SessionName,
+			"tf_aws.assume_role.external_id":     role.ExternalID,
+			"tf_aws.assume_role.source_identity": role.SourceIdentity,
+		})
+
+		client := sts_sdkv2.NewFromConfig(cfg, func(o *sts_sdkv2.Options) {
+			if v := c.Endpoints[names.STS]; v != "" {
+				o.EndpointResolver = sts_sdkv2.EndpointResolverFromURL(v)
+			}
+
+			if v := c.STSRegion; v != "" {
+				o.Region = v
+			}
+		})
+
+		provider := stscreds.NewAssumeRoleProvider(client, role.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+			o.Duration = role.Duration
+			o.RoleSessionName = role.SessionName
+
+			if role.ExternalID != "" {
+				o.ExternalID = aws_sdkv2.String(role.ExternalID)
+			}
+
+			if role.Policy != "" {
+				o.Policy = aws_sdkv2.String(role.Policy)
+			}
+
+			for _, v := range role.PolicyARNs {
+				o.PolicyARNs = append(o.PolicyARNs, ststypes.PolicyDescriptorType{
+					Arn: aws_sdkv2.String(v),
+				})
+			}
+
+			if role.SourceIdentity != "" {
+				o.SourceIdentity = aws_sdkv2.String(role.SourceIdentity)
+			}
+
+			for k, v := range role.Tags {
+				o.Tags = append(o.Tags, ststypes.Tag{
+					Key:   aws_sdkv2.String(k),
+					Value: aws_sdkv2.String(v),
+				})
+			}
+
+			o.TransitiveTagKeys = role.TransitiveTagKeys
+		})
+
+		credentials := aws_sdkv2.NewCredentialsCache(provider)
+
+		// Fail fast, reporting which role in the chain can't be assumed.
+		if _, err := credentials.Retrieve(ctx); err != nil {
+			return cfg, fmt.Errorf("assuming IAM Role (%s): %w", role.RoleARN, err)
+		}
+
+		cfg = cfg.Copy()
+		cfg.Credentials = credentials
+	}
+
+	return cfg, nil
+}
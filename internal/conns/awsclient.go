@@ -16,8 +16,10 @@ import (
 	apigatewayv2_sdkv1 "github.com/aws/aws-sdk-go/service/apigatewayv2"
 	mediaconvert_sdkv1 "github.com/aws/aws-sdk-go/service/mediaconvert"
 	s3_sdkv1 "github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/exp/slices"
 )
 
 type AWSClient struct {
@@ -31,16 +33,23 @@ type AWSClient struct {
 	ReverseDNSPrefix        string
 	ServicePackages         map[string]ServicePackage
 	Session                 *session_sdkv1.Session
+	TagPolicyConfig         *tftags.PolicyConfig
 	TerraformVersion        string
 
 	awsConfig      *aws_sdkv2.Config
+	batchTagReads  bool // From provider configuration.
 	clients        map[string]any
 	conns          map[string]any
 	endpoints      map[string]string // From provider configuration.
 	httpClient     *http.Client
 	lock           sync.Mutex
-	s3UsePathStyle bool   // From provider configuration.
-	stsRegion      string // From provider configuration.
+	rateLimiters   map[string]*ratelimit.Limiter // From provider configuration.
+	s3UsePathStyle bool                          // From provider configuration.
+	stsRegion      string                        // From provider configuration.
+	tagsBatcher    *tagsBatcher
+
+	regionalClients      map[string]*AWSClient // Per-Region copies, keyed by Region.
+	skipRegionValidation bool                  // From provider configuration.
 }
 
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
@@ -142,6 +151,20 @@ func (client *AWSClient) apiClientConfig(servicePackageName string) map[string]a
 		"partition":        client.Partition,
 		"session":          client.Session,
 	}
+	// API calls are limited across all resources and Regions of the provider instance.
+	if l, ok := client.rateLimiters[servicePackageName]; ok {
+		if client.awsConfig != nil {
+			awsConfig := client.awsConfig.Copy()
+			awsConfig.APIOptions = append(slices.Clone(awsConfig.APIOptions), ratelimit.AddSDKv2Middleware(servicePackageName, l))
+			m["aws_sdkv2_config"] = &awsConfig
+		}
+		if client.Session != nil {
+			sess := client.Session.Copy()
+			ratelimit.AddSDKv1Handlers(&sess.Handlers, servicePackageName, l)
+			m["session"] = sess
+		}
+	}
+
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = client.s3UsePathStyle
@@ -154,6 +177,13 @@ func (client *AWSClient) apiClientConfig(servicePackageName string) map[string]a
 
 // conn returns the AWS SDK for Go v1 API client for the specified service.
 func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string) (T, error) {
+	// Resources and data sources can operate in a Region other than the provider's.
+	c, err := c.regionalClientFromContext(ctx)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
@@ -203,6 +233,13 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string) (
 
 // client returns the AWS SDK for Go v2 API client for the specified service.
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string) (T, error) {
+	// Resources and data sources can operate in a Region other than the provider's.
+	c, err := c.regionalClientFromContext(ctx)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
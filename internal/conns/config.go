@@ -5,24 +5,44 @@ package conns
 
 import (
 	"context"
+	"crypto/x509"
+	"fmt"
+	"io"
 	"log"
+	"net/http"
+	"time"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awshttp_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	imds_sdkv2 "github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// externalCredentialsPlaceholder is the static access key ID and secret access key used to load the AWS SDK configuration
+// before credentials from sources not supported by AWS SDK for Go base are retrieved.
+const externalCredentialsPlaceholder = "external"
+
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	APIOptions                     []func(*middleware.Stack) error // Added to AWS SDK for Go v2 API clients.
+	APITraceFile                   string
+	AssumeRole                     []awsbase.AssumeRole // Assumed in order.
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	BatchTagReads                  bool
+	CredentialProcess              *CredentialProcess
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
@@ -30,11 +50,14 @@ type Config struct {
 	EC2MetadataServiceEndpointMode string
 	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
+	HTTPFixturesDirectory          string
+	HTTPFixturesMode               string
 	HTTPProxy                      string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
 	Profile                        string
+	RateLimits                     map[string]*ratelimit.Limiter
 	Region                         string
 	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
@@ -44,8 +67,11 @@ type Config struct {
 	SkipCredsValidation            bool
 	SkipRegionValidation           bool
 	SkipRequestingAccountId        bool
+	SDKv1ValidateHandlers          []request.NamedHandler // Added to AWS SDK for Go v1 API clients.
+	SSO                            *SSO
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	UseDualStackEndpoint           bool
@@ -79,8 +105,16 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:               c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	// Credentials from sources not supported by AWS SDK for Go base replace placeholder static credentials
+	// once the AWS SDK configuration is loaded, so that retrieving them uses its HTTP client and settings.
+	// Any IAM Roles are then assumed in turn.
+	if c.hasExternalCredentials() {
+		awsbaseConfig.AccessKey = externalCredentialsPlaceholder
+		awsbaseConfig.SecretKey = externalCredentialsPlaceholder
+		awsbaseConfig.Token = ""
+		awsbaseConfig.SkipCredsValidation = true
+	} else if len(c.AssumeRole) > 0 {
+		awsbaseConfig.AssumeRole = &c.AssumeRole[0]
 	}
 
 	if c.CustomCABundle != "" {
@@ -104,12 +138,36 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		awsbaseConfig.StsRegion = c.STSRegion
 	}
 
+	if c.HTTPFixturesDirectory != "" {
+		if err := c.configureHTTPFixtures(ctx, client, &awsbaseConfig); err != nil {
+			return nil, diag.Errorf("configuring HTTP fixtures: %s", err)
+		}
+	}
+
 	tflog.Debug(ctx, "Configuring Terraform AWS Provider")
 	ctx, cfg, err := awsbase.GetAwsConfig(ctx, &awsbaseConfig)
 	if err != nil {
 		return nil, diag.Errorf("configuring Terraform AWS Provider: %s", err)
 	}
 
+	if c.hasExternalCredentials() {
+		// Replace the placeholder credentials with ones that are refreshed on expiry.
+		credentials := aws_sdkv2.NewCredentialsCache(c.externalCredentialsProvider(cfg))
+		if _, err := credentials.Retrieve(ctx); err != nil {
+			return nil, diag.Errorf("retrieving AWS credentials: %s", err)
+		}
+		cfg.Credentials = credentials
+
+		if cfg, err = c.assumeRoles(ctx, cfg, c.AssumeRole); err != nil {
+			return nil, diag.Errorf("configuring Terraform AWS Provider: %s", err)
+		}
+	} else if len(c.AssumeRole) > 1 {
+		// AWS SDK for Go base assumes the first IAM Role. Any others are assumed in turn.
+		if cfg, err = c.assumeRoles(ctx, cfg, c.AssumeRole[1:]); err != nil {
+			return nil, diag.Errorf("configuring Terraform AWS Provider: %s", err)
+		}
+	}
+
 	if !c.SkipRegionValidation {
 		if err := awsbase.ValidateRegion(cfg.Region); err != nil {
 			return nil, diag.FromErr(err)
@@ -123,6 +181,34 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diag.Errorf("creating AWS SDK v1 session: %s", err)
 	}
 
+	if c.APITraceFile != "" {
+		tracer, err := tracing.NewFileTracer(c.APITraceFile)
+		if err != nil {
+			return nil, diag.Errorf("opening API call trace file: %s", err)
+		}
+
+		tflog.Info(ctx, "Tracing AWS API calls", map[string]any{
+			"tf_aws.api_trace_file": c.APITraceFile,
+		})
+		cfg.APIOptions = append(cfg.APIOptions, tracer.SDKv2Middleware())
+		sess.Handlers.Complete.PushBackNamed(tracer.SDKv1Handler())
+
+		// Close the trace file when Terraform stops the provider.
+		if stopCtx, ok := schema.StopContext(ctx); ok {
+			go func() {
+				<-stopCtx.Done()
+				if err := tracer.Close(); err != nil {
+					log.Printf("[WARN] Closing API call trace file: %s", err)
+				}
+			}()
+		}
+	}
+
+	cfg.APIOptions = append(cfg.APIOptions, c.APIOptions...)
+	for _, v := range c.SDKv1ValidateHandlers {
+		sess.Handlers.Validate.PushFrontNamed(v)
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	if err != nil {
@@ -168,15 +254,94 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
 	client.SetHTTPClient(sess.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.Session = sess
+	client.TagPolicyConfig = c.TagPolicyConfig
 	client.TerraformVersion = c.TerraformVersion
 
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
+	client.batchTagReads = c.BatchTagReads
 	client.clients = make(map[string]any, 0)
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
+	client.rateLimiters = c.RateLimits
 	client.s3UsePathStyle = c.S3UsePathStyle
+	client.skipRegionValidation = c.SkipRegionValidation
 	client.stsRegion = c.STSRegion
 
 	return client, nil
 }
+
+// configureHTTPFixtures wraps the HTTP client used by both AWS SDKs so that API calls
+// are served from, or recorded to, a directory of request/response fixtures.
+func (c *Config) configureHTTPFixtures(ctx context.Context, client *AWSClient, awsbaseConfig *awsbase.Config) error {
+	var realTransport http.RoundTripper
+	var timeout time.Duration
+
+	if v := client.HTTPClient(); v != nil {
+		realTransport, timeout = v.Transport, v.Timeout
+	} else {
+		opts, err := awsbaseConfig.HTTPTransportOptions()
+		if err != nil {
+			return err
+		}
+
+		httpClient := awshttp_sdkv2.NewBuildableClient().WithTransportOptions(opts)
+		transport := httpClient.GetTransport()
+
+		// AWS SDK for Go v2 can only add a custom CA bundle to its own HTTP client type.
+		if awsbaseConfig.CustomCABundle != "" {
+			reader, err := awsbaseConfig.CustomCABundleReader()
+			if err != nil {
+				return err
+			}
+
+			pem, err := io.ReadAll(reader)
+			if err != nil {
+				return err
+			}
+
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(pem) {
+				return fmt.Errorf("no certificates found in custom CA bundle (%s)", awsbaseConfig.CustomCABundle)
+			}
+
+			transport.TLSClientConfig.RootCAs = pool
+			awsbaseConfig.CustomCABundle = ""
+		}
+
+		realTransport, timeout = transport, httpClient.GetTimeout()
+	}
+
+	// Fixtures are stored per Region so that aliased provider configurations don't overwrite one another.
+	name := c.Region
+	if name == "" {
+		name = "default"
+	}
+
+	tflog.Info(ctx, "Using HTTP fixtures", map[string]any{
+		"tf_aws.http_fixtures.directory": c.HTTPFixturesDirectory,
+		"tf_aws.http_fixtures.mode":      c.HTTPFixturesMode,
+		"tf_aws.http_fixtures.name":      name,
+	})
+
+	transport, err := vcr.NewTransport(ctx, c.HTTPFixturesDirectory, name, c.HTTPFixturesMode, realTransport)
+	if err != nil {
+		return err
+	}
+
+	awsbaseConfig.HTTPClient = &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+	}
+
+	if c.HTTPFixturesMode == vcr.ModeReplay {
+		// Requests are never sent to AWS, so no real credentials are needed.
+		awsbaseConfig.AccessKey = "mock_access_key"
+		awsbaseConfig.SecretKey = "mock_secret_key"
+		awsbaseConfig.Token = ""
+		awsbaseConfig.Profile = ""
+		awsbaseConfig.EC2MetadataServiceEnableState = imds_sdkv2.ClientDisabled
+	}
+
+	return nil
+}
@@ -25,6 +25,27 @@ type ServicePackage interface {
 	ServicePackageName() string
 }
 
+// ServicePackageWithEphemeralResources is an interface that extends ServicePackage with ephemeral resources.
+// Ephemeral resources are resources that are not part of the Terraform plan or state.
+type ServicePackageWithEphemeralResources interface {
+	ServicePackage
+	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
+}
+
+// ServicePackageWithGlobalResources is an interface that extends ServicePackage for AWS services whose resources are global.
+// Global resources and data sources have no `region` argument.
+type ServicePackageWithGlobalResources interface {
+	ServicePackage
+	IsGlobal() bool
+}
+
+// IsGlobalServicePackage returns whether the specified service package's resources and data sources are global.
+func IsGlobalServicePackage(sp ServicePackage) bool {
+	v, ok := sp.(ServicePackageWithGlobalResources)
+
+	return ok && v.IsGlobal()
+}
+
 type (
 	contextKeyType int
 )
@@ -36,6 +57,8 @@ var (
 // InContext represents the resource information kept in Context.
 type InContext struct {
 	IsDataSource       bool   // Data source?
+	IsEphemeral        bool   // Ephemeral resource?
+	Region             string // Region override, e.g. "us-west-2"
 	ResourceName       string // Friendly resource name, e.g. "Subnet"
 	ServicePackageName string // Canonical name defined as a constant in names package
 }
@@ -50,6 +73,16 @@ func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName
 	return context.WithValue(ctx, contextKey, &v)
 }
 
+func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+	v := InContext{
+		IsEphemeral:        true,
+		ResourceName:       resourceName,
+		ServicePackageName: servicePackageName,
+	}
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
 func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
 	v := InContext{
 		ResourceName:       resourceName,
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/processcreds"
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
+	sso_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sso"
+)
+
+// CredentialProcess configures credentials sourced from an external process.
+type CredentialProcess struct {
+	Command string
+	Timeout time.Duration
+}
+
+// SSO configures credentials sourced from AWS IAM Identity Center using a cached access token.
+type SSO struct {
+	AccountID       string
+	CachedTokenPath string
+	Endpoint        string
+	Region          string
+	RoleName        string
+	StartURL        string
+}
+
+// hasExternalCredentials returns whether credentials are configured from sources not supported by AWS SDK for Go base.
+func (c *Config) hasExternalCredentials() bool {
+	return c.CredentialProcess != nil || c.SSO != nil
+}
+
+// externalCredentialsProvider returns a provider for any credentials configured from sources
+// not supported by AWS SDK for Go base, or nil if there are none.
+// Any AWS API calls made to retrieve the credentials use the HTTP client, User-Agent and endpoint settings of the specified configuration.
+func (c *Config) externalCredentialsProvider(cfg aws_sdkv2.Config) aws_sdkv2.CredentialsProvider {
+	switch {
+	case c.CredentialProcess != nil:
+		return processcreds.NewProvider(c.CredentialProcess.Command, func(o *processcreds.Options) {
+			if v := c.CredentialProcess.Timeout; v > 0 {
+				o.Timeout = v
+			}
+		})
+
+	case c.SSO != nil:
+		client := sso_sdkv2.NewFromConfig(cfg, func(o *sso_sdkv2.Options) {
+			o.Region = c.SSO.Region
+
+			if v := c.SSO.Endpoint; v != "" {
+				o.EndpointResolver = sso_sdkv2.EndpointResolverFromURL(v)
+			}
+		})
+
+		return ssocreds.New(client, c.SSO.AccountID, c.SSO.RoleName, c.SSO.StartURL, func(o *ssocreds.Options) {
+			if v := c.SSO.CachedTokenPath; v != "" {
+				o.CachedTokenFilepath = v
+			}
+		})
+	}
+
+	return nil
+}
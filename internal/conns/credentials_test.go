@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// isolateCredentialsEnv prevents credentials and configuration in the environment being used.
+func isolateCredentialsEnv(t *testing.T) {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+	t.Setenv("AWS_CONFIG_FILE", filepath.Join(dir, "config"))
+	t.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+	t.Setenv("AWS_PROFILE", "")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "")
+	t.Setenv("AWS_SESSION_TOKEN", "")
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(dir, "credentials"))
+}
+
+func TestConfigureProviderCredentialProcessAssumeRole(t *testing.T) { //nolint:paralleltest // Sets environment variables
+	if runtime.GOOS == "windows" {
+		t.Skip("credential process stub is a shell script")
+	}
+
+	isolateCredentialsEnv(t)
+
+	ctx := context.Background()
+
+	command := filepath.Join(t.TempDir(), "credential-process")
+	script := `#!/bin/sh
+echo '{"Version": 1, "AccessKeyId": "AKIDPROCESS", "SecretAccessKey": "process-secret", "SessionToken": "process-token"}'
+`
+	if err := os.WriteFile(command, []byte(script), 0700); err != nil { //nolint:gosec // Executable.
+		t.Fatal(err)
+	}
+
+	// Each role's credentials are returned with an access key ID derived from its name.
+	var mu sync.Mutex
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		roleName := r.Form.Get("RoleArn")[strings.LastIndex(r.Form.Get("RoleArn"), "/")+1:]
+		accessKeyID, _, _ := strings.Cut(strings.TrimPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential="), "/")
+
+		mu.Lock()
+		calls = append(calls, fmt.Sprintf("%s %s by %s", r.Form.Get("Action"), roleName, accessKeyID))
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprintf(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::123456789012:assumed-role/%[1]s/session</Arn>
+      <AssumedRoleId>AROA:session</AssumedRoleId>
+    </AssumedRoleUser>
+    <Credentials>
+      <AccessKeyId>AKID%[2]s</AccessKeyId>
+      <SecretAccessKey>%[1]s-secret</SecretAccessKey>
+      <SessionToken>%[1]s-token</SessionToken>
+      <Expiration>2099-01-01T00:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleResponse>`, roleName, strings.ToUpper(roleName))
+	}))
+	defer server.Close()
+
+	c := &Config{
+		AssumeRole: []awsbase.AssumeRole{
+			{RoleARN: "arn:aws:iam::123456789012:role/first", SessionName: "session"},  //lintignore:AWSAT005
+			{RoleARN: "arn:aws:iam::123456789012:role/second", SessionName: "session"}, //lintignore:AWSAT005
+		},
+		CredentialProcess: &CredentialProcess{
+			Command: command,
+		},
+		Endpoints: map[string]string{
+			names.STS: server.URL,
+		},
+		MaxRetries:              1,
+		Region:                  "us-west-2", //lintignore:AWSAT003
+		SkipCredsValidation:     true,
+		SkipRequestingAccountId: true,
+		TerraformVersion:        "1.0.0",
+	}
+
+	client, diags := c.ConfigureProvider(ctx, new(AWSClient))
+	if diags.HasError() {
+		t.Fatalf("configuring provider: %v", diags)
+	}
+
+	credentials, err := client.awsConfig.Credentials.Retrieve(ctx)
+	if err != nil {
+		t.Fatalf("retrieving credentials: %s", err)
+	}
+
+	if got, expected := credentials.AccessKeyID, "AKIDSECOND"; got != expected {
+		t.Errorf("got access key ID %q, expected %q", got, expected)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	// The first role is assumed using the credential process's credentials, and each later role using the previous role's.
+	expected := []string{"AssumeRole first by AKIDPROCESS", "AssumeRole second by AKIDFIRST"}
+	if strings.Join(calls, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("got calls %q, expected %q", calls, expected)
+	}
+}
+
+func TestConfigureProviderSSO(t *testing.T) { //nolint:paralleltest // Sets environment variables
+	isolateCredentialsEnv(t)
+
+	ctx := context.Background()
+
+	cachedTokenPath := filepath.Join(t.TempDir(), "token.json")
+	if err := os.WriteFile(cachedTokenPath, []byte(`{"accessToken": "sso-token", "expiresAt": "2099-01-01T00:00:00Z"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var mu sync.Mutex
+	var userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/federation/credentials" || r.Header.Get("X-Amz-Sso_bearer_token") != "sso-token" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+
+		mu.Lock()
+		userAgent = r.Header.Get("User-Agent")
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"roleCredentials": {"accessKeyId": "AKIDSSO", "secretAccessKey": "sso-secret", "sessionToken": "sso-session-token", "expiration": 4070908800000}}`)
+	}))
+	defer server.Close()
+
+	c := &Config{
+		MaxRetries:              1,
+		Region:                  "us-west-2", //lintignore:AWSAT003
+		SkipCredsValidation:     true,
+		SkipRequestingAccountId: true,
+		SSO: &SSO{
+			AccountID:       "123456789012",
+			CachedTokenPath: cachedTokenPath,
+			Endpoint:        server.URL,
+			Region:          "us-east-1", //lintignore:AWSAT003
+			RoleName:        "Developer",
+			StartURL:        "https://example.awsapps.com/start",
+		},
+		TerraformVersion: "1.0.0",
+	}
+
+	client, diags := c.ConfigureProvider(ctx, new(AWSClient))
+	if diags.HasError() {
+		t.Fatalf("configuring provider: %v", diags)
+	}
+
+	credentials, err := client.awsConfig.Credentials.Retrieve(ctx)
+	if err != nil {
+		t.Fatalf("retrieving credentials: %s", err)
+	}
+
+	if got, expected := credentials.AccessKeyID, "AKIDSSO"; got != expected {
+		t.Errorf("got access key ID %q, expected %q", got, expected)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	// The AWS SSO client is configured like the provider's other AWS API clients.
+	if !strings.Contains(userAgent, "terraform-provider-aws/") {
+		t.Errorf("got User-Agent %q, expected provider User-Agent", userAgent)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"strings"
+
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+// RegionalClient returns the provider's instance data for operating in the specified Region.
+// API clients are created lazily and cached per Region.
+// If the Region is empty or is the provider's configured Region then the receiver is returned.
+func (client *AWSClient) RegionalClient(region string) (*AWSClient, error) {
+	if region == "" || region == client.Region {
+		return client, nil
+	}
+
+	client.lock.Lock()
+	defer client.lock.Unlock()
+
+	if v, ok := client.regionalClients[region]; ok {
+		return v, nil
+	}
+
+	if !client.skipRegionValidation {
+		if err := awsbase.ValidateRegion(region); err != nil {
+			return nil, err
+		}
+	}
+
+	dnsSuffix, partition := client.DNSSuffix, client.Partition
+	if p, ok := endpoints_sdkv1.PartitionForRegion(endpoints_sdkv1.DefaultPartitions(), region); ok {
+		dnsSuffix, partition = p.DNSSuffix(), p.ID()
+	}
+
+	awsConfig := client.awsConfig.Copy()
+	awsConfig.Region = region
+
+	regionalClient := &AWSClient{
+		AccountID:         client.AccountID,
+		DefaultTagsConfig: client.DefaultTagsConfig,
+		DNSSuffix:         dnsSuffix,
+		IgnoreTagsConfig:  client.IgnoreTagsConfig,
+		Partition:         partition,
+		Region:            region,
+		ReverseDNSPrefix:  ReverseDNS(dnsSuffix),
+		ServicePackages:   client.ServicePackages,
+		TagPolicyConfig:   client.TagPolicyConfig,
+		TerraformVersion:  client.TerraformVersion,
+
+		awsConfig:            &awsConfig,
+		batchTagReads:        client.batchTagReads,
+		clients:              make(map[string]any, 0),
+		conns:                make(map[string]any, 0),
+		endpoints:            client.endpoints,
+		httpClient:           client.httpClient,
+		rateLimiters:         client.rateLimiters,
+		s3UsePathStyle:       client.s3UsePathStyle,
+		skipRegionValidation: client.skipRegionValidation,
+		stsRegion:            client.stsRegion,
+	}
+
+	if client.Session != nil {
+		regionalClient.Session = client.Session.Copy(&aws_sdkv1.Config{Region: aws_sdkv1.String(region)})
+	}
+
+	if client.regionalClients == nil {
+		client.regionalClients = make(map[string]*AWSClient)
+	}
+	client.regionalClients[region] = regionalClient
+
+	return regionalClient, nil
+}
+
+// regionalClientFromContext returns the provider's instance data for any Region override in Context.
+func (client *AWSClient) regionalClientFromContext(ctx context.Context) (*AWSClient, error) {
+	if v, ok := FromContext(ctx); ok {
+		return client.RegionalClient(v.Region)
+	}
+
+	return client, nil
+}
+
+// SplitRegionalImportID splits an import ID of the form `<id>@<region>` into its constituent parts.
+// If the ID doesn't end with a valid Region then the ID is returned unchanged.
+func SplitRegionalImportID(id string) (string, string, bool) {
+	i := strings.LastIndex(id, "@")
+
+	if i <= 0 {
+		return id, "", false
+	}
+
+	region := id[i+1:]
+
+	if err := awsbase.ValidateRegion(region); err != nil {
+		return id, "", false
+	}
+
+	return id[:i], region, true
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+)
+
+func TestAWSClientRegionalClient(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		AccountID: "123456789012",
+		DNSSuffix: "amazonaws.com",
+		Partition: "aws",
+		Region:    "us-west-2",
+
+		awsConfig: &aws_sdkv2.Config{Region: "us-west-2"},
+	}
+
+	testCases := []struct {
+		Name              string
+		Region            string
+		ExpectedDNSSuffix string
+		ExpectedPartition string
+		ExpectedRegion    string
+		ExpectError       bool
+		ExpectSame        bool
+	}{
+		{
+			Name:           "empty",
+			ExpectedRegion: "us-west-2",
+			ExpectSame:     true,
+		},
+		{
+			Name:           "provider Region",
+			Region:         "us-west-2",
+			ExpectedRegion: "us-west-2",
+			ExpectSame:     true,
+		},
+		{
+			Name:              "same partition",
+			Region:            "eu-west-1",
+			ExpectedDNSSuffix: "amazonaws.com",
+			ExpectedPartition: "aws",
+			ExpectedRegion:    "eu-west-1",
+		},
+		{
+			Name:              "other partition",
+			Region:            "cn-north-1",
+			ExpectedDNSSuffix: "amazonaws.com.cn",
+			ExpectedPartition: "aws-cn",
+			ExpectedRegion:    "cn-north-1",
+		},
+		{
+			Name:        "invalid",
+			Region:      "mars-north-1",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := client.RegionalClient(testCase.Region)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectSame {
+				if got != client {
+					t.Error("expected provider's client")
+				}
+				return
+			}
+
+			if got.Region != testCase.ExpectedRegion {
+				t.Errorf("got Region %s, expected %s", got.Region, testCase.ExpectedRegion)
+			}
+			if got.awsConfig.Region != testCase.ExpectedRegion {
+				t.Errorf("got AWS SDK for Go v2 Region %s, expected %s", got.awsConfig.Region, testCase.ExpectedRegion)
+			}
+			if got.DNSSuffix != testCase.ExpectedDNSSuffix {
+				t.Errorf("got DNS suffix %s, expected %s", got.DNSSuffix, testCase.ExpectedDNSSuffix)
+			}
+			if got.Partition != testCase.ExpectedPartition {
+				t.Errorf("got partition %s, expected %s", got.Partition, testCase.ExpectedPartition)
+			}
+			if got.AccountID != client.AccountID {
+				t.Errorf("got account ID %s, expected %s", got.AccountID, client.AccountID)
+			}
+
+			again, err := client.RegionalClient(testCase.Region)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if again != got {
+				t.Error("expected cached client")
+			}
+		})
+	}
+
+	if client.awsConfig.Region != "us-west-2" {
+		t.Errorf("provider's AWS SDK for Go v2 Region changed to %s", client.awsConfig.Region)
+	}
+}
+
+func TestSplitRegionalImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		ID             string
+		ExpectedID     string
+		ExpectedRegion string
+		ExpectedOK     bool
+	}{
+		{
+			ID:         "vpc-12345678",
+			ExpectedID: "vpc-12345678",
+		},
+		{
+			ID:             "vpc-12345678@us-west-2",
+			ExpectedID:     "vpc-12345678",
+			ExpectedRegion: "us-west-2",
+			ExpectedOK:     true,
+		},
+		{
+			ID:             "user@example.com@eu-central-1",
+			ExpectedID:     "user@example.com",
+			ExpectedRegion: "eu-central-1",
+			ExpectedOK:     true,
+		},
+		{
+			ID:         "user@example.com",
+			ExpectedID: "user@example.com",
+		},
+		{
+			ID:         "@us-west-2",
+			ExpectedID: "@us-west-2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.ID, func(t *testing.T) {
+			t.Parallel()
+
+			id, region, ok := SplitRegionalImportID(testCase.ID)
+
+			if id != testCase.ExpectedID || region != testCase.ExpectedRegion || ok != testCase.ExpectedOK {
+				t.Errorf("got (%q, %q, %t), expected (%q, %q, %t)", id, region, ok, testCase.ExpectedID, testCase.ExpectedRegion, testCase.ExpectedOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	resourcegroupstaggingapi_sdkv1 "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+)
+
+// tagsBatchSize is the maximum number of ARNs in a GetResources ResourceARNList.
+const tagsBatchSize = 100
+
+// tagsBatcher combines concurrent tag reads into Resource Groups Tagging API GetResources calls with up to tagsBatchSize ARNs each.
+// While one call is in progress, ARNs from other reads are queued for the next.
+// Tags returned are cached for later reads of the same resources.
+type tagsBatcher struct {
+	getResourceTags func(context.Context, []string) (map[string]map[string]string, error)
+
+	mu      sync.Mutex
+	cache   map[string]map[string]string // Tags read, keyed by ARN.
+	pending *tagsBatch                   // Batch accepting ARNs, if any.
+	send    sync.Mutex                   // Held while a batch is sent.
+	written map[string]bool              // ARNs of resources whose tags have been written.
+}
+
+// tagsBatch is a single GetResources call.
+type tagsBatch struct {
+	arns []string
+	done chan struct{} // Closed once tags or err is set.
+	tags map[string]map[string]string
+	err  error
+}
+
+// CachedResourceTags returns the tags of the resource with the specified ARN, read in a batch with the tags of other resources being refreshed at the same time
+// using the Resource Groups Tagging API GetResources operation, or from the results of an earlier batch.
+// ok is false if batched tag reads are not enabled, if the identifier isn't an ARN, if the resource's tags have been written by this provider instance,
+// if the batch failed or if the resource wasn't returned by GetResources,
+// e.g. because the resource has never been tagged or its type isn't supported by the Resource Groups Tagging API.
+// Callers then read tags with the service's own tagging API.
+func (client *AWSClient) CachedResourceTags(ctx context.Context, resourceARN string) (map[string]string, bool) {
+	batcher, region, ok := client.resourceTagsBatcher(ctx, resourceARN)
+	if !ok {
+		return nil, false
+	}
+
+	tags, ok, err := batcher.get(ctx, resourceARN)
+
+	if err != nil {
+		log.Printf("[WARN] Reading tags in bulk (%s), falling back to per-resource reads: %s", region, err)
+		return nil, false
+	}
+
+	return tags, ok
+}
+
+// InvalidateCachedResourceTags records that the tags of the resource with the specified ARN have been written.
+// The Resource Groups Tagging API is eventually consistent, so the resource's tags are no longer read in a batch
+// and any cached tags are discarded.
+func (client *AWSClient) InvalidateCachedResourceTags(ctx context.Context, resourceARN string) {
+	if batcher, _, ok := client.resourceTagsBatcher(ctx, resourceARN); ok {
+		batcher.invalidate(resourceARN)
+	}
+}
+
+// resourceTagsBatcher returns the batcher for the tags of the resource with the specified ARN and the Region it operates in.
+func (client *AWSClient) resourceTagsBatcher(ctx context.Context, resourceARN string) (*tagsBatcher, string, bool) {
+	if !client.batchTagReads || !arn.IsARN(resourceARN) {
+		return nil, "", false
+	}
+
+	// Resources and data sources can operate in a Region other than the provider's.
+	client, err := client.regionalClientFromContext(ctx)
+	if err != nil {
+		return nil, "", false
+	}
+
+	client.lock.Lock()
+	defer client.lock.Unlock()
+
+	if client.tagsBatcher == nil {
+		client.tagsBatcher = &tagsBatcher{
+			getResourceTags: func(ctx context.Context, arns []string) (map[string]map[string]string, error) {
+				return getResourceTags(ctx, client.ResourceGroupsTaggingAPIConn(ctx), arns)
+			},
+		}
+	}
+
+	return client.tagsBatcher, client.Region, true
+}
+
+// invalidate discards any cached tags of the resource and excludes it from future batches.
+func (b *tagsBatcher) invalidate(resourceARN string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.written == nil {
+		b.written = make(map[string]bool)
+	}
+	b.written[resourceARN] = true
+	delete(b.cache, resourceARN)
+}
+
+// get returns the resource's cached tags or adds the ARN to a batch and returns the resource's tags once the batch has been sent.
+// ok is false if the resource's tags have been written or if the resource wasn't returned by GetResources.
+// The first caller to add an ARN to a batch sends it. The batch isn't canceled with the first caller's Context.
+func (b *tagsBatcher) get(ctx context.Context, resourceARN string) (map[string]string, bool, error) {
+	b.mu.Lock()
+	if b.written[resourceARN] {
+		b.mu.Unlock()
+		return nil, false, nil
+	}
+	if tags, ok := b.cache[resourceARN]; ok {
+		b.mu.Unlock()
+		return tags, true, nil
+	}
+	batch := b.pending
+	leader := batch == nil
+	if leader {
+		batch = &tagsBatch{
+			done: make(chan struct{}),
+		}
+		b.pending = batch
+	}
+	batch.arns = append(batch.arns, resourceARN)
+	if len(batch.arns) == tagsBatchSize {
+		b.pending = nil
+	}
+	b.mu.Unlock()
+
+	if leader {
+		b.send.Lock()
+
+		b.mu.Lock()
+		if b.pending == batch {
+			b.pending = nil
+		}
+		b.mu.Unlock()
+
+		batch.tags, batch.err = b.getResourceTags(context.WithoutCancel(ctx), batch.arns)
+
+		if batch.err == nil {
+			b.mu.Lock()
+			if b.cache == nil {
+				b.cache = make(map[string]map[string]string)
+			}
+			for k, v := range batch.tags {
+				// Tags may have been written while the batch was being sent.
+				if !b.written[k] {
+					b.cache[k] = v
+				}
+			}
+			b.mu.Unlock()
+		}
+
+		close(batch.done)
+
+		b.send.Unlock()
+	}
+
+	select {
+	case <-batch.done:
+		if batch.err != nil {
+			return nil, false, batch.err
+		}
+
+		tags, ok := batch.tags[resourceARN]
+
+		return tags, ok, nil
+	case <-ctx.Done():
+		return nil, false, ctx.Err()
+	}
+}
+
+// getResourceTags returns the tags of the specified resources, keyed by resource ARN.
+// Resources not returned by GetResources are omitted.
+func getResourceTags(ctx context.Context, conn *resourcegroupstaggingapi_sdkv1.ResourceGroupsTaggingAPI, arns []string) (map[string]map[string]string, error) {
+	// Pagination parameters can't be specified with ResourceARNList.
+	input := &resourcegroupstaggingapi_sdkv1.GetResourcesInput{
+		ResourceARNList: aws_sdkv1.StringSlice(arns),
+	}
+
+	output, err := conn.GetResourcesWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]map[string]string, len(output.ResourceTagMappingList))
+
+	for _, v := range output.ResourceTagMappingList {
+		if v == nil {
+			continue
+		}
+
+		m := make(map[string]string, len(v.Tags))
+		for _, v := range v.Tags {
+			m[aws_sdkv1.StringValue(v.Key)] = aws_sdkv1.StringValue(v.Value)
+		}
+		tags[aws_sdkv1.StringValue(v.ResourceARN)] = m
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestAWSClientCachedResourceTags(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	const resourceARN = "arn:aws:logs:us-west-2:123456789012:log-group:test" //lintignore:AWSAT003,AWSAT005
+
+	testCases := []struct {
+		Name          string
+		BatchTagReads bool
+		Identifier    string
+		Written       bool // Tags written before being read.
+		Reads         int  // Defaults to 1.
+		Err           error
+		ExpectedARNs  []string
+		ExpectedTags  map[string]string
+		ExpectedOK    bool
+	}{
+		{
+			Name:       "disabled",
+			Identifier: resourceARN,
+		},
+		{
+			Name:          "not an ARN",
+			BatchTagReads: true,
+			Identifier:    "test",
+		},
+		{
+			Name:          "not found",
+			BatchTagReads: true,
+			Identifier:    resourceARN + "-2",
+			ExpectedARNs:  []string{resourceARN + "-2"},
+		},
+		{
+			Name:          "error",
+			BatchTagReads: true,
+			Identifier:    resourceARN,
+			Err:           errors.New("AccessDeniedException"),
+			ExpectedARNs:  []string{resourceARN},
+		},
+		{
+			Name:          "found",
+			BatchTagReads: true,
+			Identifier:    resourceARN,
+			ExpectedARNs:  []string{resourceARN},
+			ExpectedTags:  map[string]string{"Name": "test"},
+			ExpectedOK:    true,
+		},
+		{
+			Name:          "cached",
+			BatchTagReads: true,
+			Identifier:    resourceARN,
+			Reads:         2,
+			ExpectedARNs:  []string{resourceARN},
+			ExpectedTags:  map[string]string{"Name": "test"},
+			ExpectedOK:    true,
+		},
+		{
+			Name:          "written",
+			BatchTagReads: true,
+			Identifier:    resourceARN,
+			Written:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var arns []string
+			client := &AWSClient{
+				batchTagReads: testCase.BatchTagReads,
+				tagsBatcher: &tagsBatcher{
+					getResourceTags: func(_ context.Context, v []string) (map[string]map[string]string, error) {
+						arns = append(arns, v...)
+
+						if testCase.Err != nil {
+							return nil, testCase.Err
+						}
+
+						return map[string]map[string]string{
+							resourceARN: {"Name": "test"},
+						}, nil
+					},
+				},
+			}
+
+			ctx := context.Background()
+
+			if testCase.Written {
+				client.InvalidateCachedResourceTags(ctx, testCase.Identifier)
+			}
+
+			var tags map[string]string
+			var ok bool
+			for i := 0; i < max(testCase.Reads, 1); i++ {
+				tags, ok = client.CachedResourceTags(ctx, testCase.Identifier)
+			}
+
+			if ok != testCase.ExpectedOK {
+				t.Errorf("got ok %t, expected %t", ok, testCase.ExpectedOK)
+			}
+
+			if !reflect.DeepEqual(tags, testCase.ExpectedTags) {
+				t.Errorf("got tags %v, expected %v", tags, testCase.ExpectedTags)
+			}
+
+			if !reflect.DeepEqual(arns, testCase.ExpectedARNs) {
+				t.Errorf("got GetResources ARNs %v, expected %v", arns, testCase.ExpectedARNs)
+			}
+		})
+	}
+}
+
+func TestTagsBatcherGet(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const n = 2*tagsBatchSize + 50
+
+	var (
+		mu      sync.Mutex
+		batches [][]string
+	)
+	release := make(chan struct{})
+	batcher := &tagsBatcher{
+		getResourceTags: func(_ context.Context, arns []string) (map[string]map[string]string, error) {
+			<-release
+
+			mu.Lock()
+			batches = append(batches, arns)
+			mu.Unlock()
+
+			tags := make(map[string]map[string]string, len(arns))
+			for _, v := range arns {
+				tags[v] = map[string]string{"Name": v}
+			}
+
+			return tags, nil
+		},
+	}
+
+	// The first batch is held until all other ARNs have been queued.
+	arns := make([]string, n)
+	for i := range arns {
+		arns[i] = fmt.Sprintf("arn:aws:logs:us-west-2:123456789012:log-group:test-%d", i) //lintignore:AWSAT003,AWSAT005
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, n)
+
+	get := func(i int) {
+		defer wg.Done()
+
+		tags, ok, err := batcher.get(ctx, arns[i])
+		if err == nil && (!ok || tags["Name"] != arns[i]) {
+			err = fmt.Errorf("got tags %v", tags)
+		}
+		errs[i] = err
+	}
+
+	wg.Add(1)
+	go get(0)
+
+	for {
+		batcher.mu.Lock()
+		sent := batcher.pending == nil
+		batcher.mu.Unlock()
+		if sent {
+			break
+		}
+	}
+
+	for i := 1; i < n; i++ {
+		wg.Add(1)
+		go get(i)
+	}
+
+	for {
+		batcher.mu.Lock()
+		queued := batcher.pending != nil && len(batcher.pending.arns) == (n-1)%tagsBatchSize
+		batcher.mu.Unlock()
+		if queued {
+			break
+		}
+	}
+
+	close(release)
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("ARN %d: %s", i, err)
+		}
+	}
+
+	var total int
+	for _, v := range batches {
+		if len(v) > tagsBatchSize {
+			t.Errorf("got batch of %d ARNs, expected no more than %d", len(v), tagsBatchSize)
+		}
+		total += len(v)
+	}
+
+	if total != n {
+		t.Errorf("got %d ARNs, expected %d", total, n)
+	}
+
+	if got, expected := len(batches), 4; got != expected {
+		t.Errorf("got %d GetResources calls, expected %d", got, expected)
+	}
+}
+
+func TestTagsBatcherGetCanceledContext(t *testing.T) {
+	t.Parallel()
+
+	const resourceARN = "arn:aws:logs:us-west-2:123456789012:log-group:test" //lintignore:AWSAT003,AWSAT005
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var sendErr error
+	batcher := &tagsBatcher{
+		getResourceTags: func(ctx context.Context, arns []string) (map[string]map[string]string, error) {
+			// The batch is sent on behalf of all callers.
+			sendErr = ctx.Err()
+
+			return map[string]map[string]string{
+				resourceARN: {"Name": "test"},
+			}, nil
+		},
+	}
+
+	_, _, _ = batcher.get(ctx, resourceARN)
+
+	if sendErr != nil {
+		t.Errorf("got GetResources Context error %s, expected none", sendErr)
+	}
+
+	tags, ok, err := batcher.get(context.Background(), resourceARN)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !ok || tags["Name"] != "test" {
+		t.Errorf("got cached tags %v (%t), expected %v", tags, ok, map[string]string{"Name": "test"})
+	}
+}
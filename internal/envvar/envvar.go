@@ -68,6 +68,23 @@ const (
 	AccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
 )
 
+// Custom environment variables used to serve AWS API calls from recorded HTTP fixtures.
+// Equivalent to the provider's http_fixtures configuration block.
+const (
+	// The directory containing the HTTP fixtures
+	HTTPFixturesDirectory = "TF_AWS_HTTP_FIXTURES_DIRECTORY"
+
+	// Whether to replay (default) or record the HTTP fixtures
+	HTTPFixturesMode = "TF_AWS_HTTP_FIXTURES_MODE"
+)
+
+// Custom environment variable used to trace AWS API calls.
+// Equivalent to the provider's api_trace_file argument.
+const (
+	// The file to which a JSON Lines record of every AWS API call is appended
+	APITraceFile = "TF_AWS_API_TRACE_FILE"
+)
+
 // Custom environment variables used for assuming a role with resource sweepers
 const (
 	// The ARN of the IAM Role to assume
@@ -84,6 +101,27 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to select the resources deleted by resource sweepers
+const (
+	// Whether to list the resources that would be deleted without deleting them
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Comma-separated list of `key=value` or `key` tags that resources must all have to be deleted
+	SweepIncludeTags = "TF_AWS_SWEEP_INCLUDE_TAGS"
+
+	// Comma-separated list of `key=value` or `key` tags that prevent resources with any of them being deleted
+	SweepExcludeTags = "TF_AWS_SWEEP_EXCLUDE_TAGS"
+
+	// Comma-separated list of name prefixes, one of which resource names must have to be deleted
+	SweepIncludeNamePrefixes = "TF_AWS_SWEEP_INCLUDE_NAME_PREFIXES"
+
+	// Comma-separated list of name prefixes that prevent resources whose names have any of them being deleted
+	SweepExcludeNamePrefixes = "TF_AWS_SWEEP_EXCLUDE_NAME_PREFIXES"
+
+	// Minimum age, as a Go duration such as `24h`, of resources to be deleted
+	SweepMinAge = "TF_AWS_SWEEP_MIN_AGE"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	JSONType = jsonType{}
+)
+
+var (
+	_ basetypes.StringTypable  = JSONType
+	_ xattr.TypeWithValidate   = JSONType
+	_ basetypes.StringValuable = JSON{}
+
+	_ basetypes.StringValuableWithSemanticEquals = JSON{}
+)
+
+// jsonType is a string type holding a JSON document.
+// Values are semantically equal if they decode to the same JSON value.
+type jsonType struct {
+	basetypes.StringType
+}
+
+func (t jsonType) Equal(o attr.Type) bool {
+	other, ok := o.(jsonType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t jsonType) String() string {
+	return "types.JSONType"
+}
+
+func (t jsonType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return JSON{StringValue: in}, nil
+}
+
+func (t jsonType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t jsonType) ValueType(context.Context) attr.Value {
+	return JSON{}
+}
+
+// Validate implements type validation.
+func (t jsonType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !in.Type().Is(tftypes.String) {
+		diags.AddAttributeError(
+			path,
+			"JSON Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected String value, received %T with value: %v", in, in),
+		)
+		return diags
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value string
+	err := in.As(&value)
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			"JSON Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot convert value to string: %s", err),
+		)
+		return diags
+	}
+
+	if !json.Valid([]byte(value)) {
+		diags.AddAttributeError(
+			path,
+			"JSON Type Validation Error",
+			fmt.Sprintf("Value %q is not valid JSON.", value),
+		)
+		return diags
+	}
+
+	return diags
+}
+
+func (t jsonType) Description() string {
+	return `A JSON document.`
+}
+
+func JSONNull() JSON {
+	return JSON{StringValue: basetypes.NewStringNull()}
+}
+
+func JSONUnknown() JSON {
+	return JSON{StringValue: basetypes.NewStringUnknown()}
+}
+
+func JSONValue(value string) JSON {
+	return JSON{StringValue: basetypes.NewStringValue(value)}
+}
+
+type JSON struct {
+	basetypes.StringValue
+}
+
+func (v JSON) Equal(o attr.Value) bool {
+	other, ok := o.(JSON)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v JSON) Type(context.Context) attr.Type {
+	return JSONType
+}
+
+// StringSemanticEquals returns true if the given JSON document is equivalent,
+// ignoring insignificant whitespace and object key order.
+func (v JSON) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(JSON)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	var o1, o2 interface{}
+
+	if err := json.Unmarshal([]byte(v.ValueString()), &o1); err != nil {
+		return false, diags
+	}
+
+	if err := json.Unmarshal([]byte(newValue.ValueString()), &o2); err != nil {
+		return false, diags
+	}
+
+	return reflect.DeepEqual(o1, o2), diags
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestJSONTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		val      tftypes.Value
+		expected attr.Value
+	}{
+		"null value": {
+			val:      tftypes.NewValue(tftypes.String, nil),
+			expected: fwtypes.JSONNull(),
+		},
+		"unknown value": {
+			val:      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: fwtypes.JSONUnknown(),
+		},
+		"valid JSON": {
+			val:      tftypes.NewValue(tftypes.String, `{"a": 1}`),
+			expected: fwtypes.JSONValue(`{"a": 1}`),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			val, err := fwtypes.JSONType.ValueFromTerraform(ctx, test.val)
+
+			if err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(val, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestJSONTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         tftypes.Value
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"not a string": {
+			val:         tftypes.NewValue(tftypes.Bool, true),
+			expectError: true,
+		},
+		"unknown string": {
+			val: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"null string": {
+			val: tftypes.NewValue(tftypes.String, nil),
+		},
+		"valid string": {
+			val: tftypes.NewValue(tftypes.String, `{"a": [1, 2, {"b": null}]}`),
+		},
+		"invalid string": {
+			val:         tftypes.NewValue(tftypes.String, `{"a": `),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			diags := fwtypes.JSONType.Validate(ctx, test.val, path.Root("test"))
+
+			if !diags.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if diags.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %#v", diags)
+			}
+		})
+	}
+}
+
+func TestJSONStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		val1, val2 fwtypes.JSON
+		equals     bool
+	}{
+		"identical": {
+			val1:   fwtypes.JSONValue(`{"a": 1}`),
+			val2:   fwtypes.JSONValue(`{"a": 1}`),
+			equals: true,
+		},
+		"whitespace": {
+			val1:   fwtypes.JSONValue(`{"a":1,"b":[true]}`),
+			val2:   fwtypes.JSONValue("{\n  \"a\": 1,\n  \"b\": [\n    true\n  ]\n}"),
+			equals: true,
+		},
+		"key order": {
+			val1:   fwtypes.JSONValue(`{"a": 1, "b": 2}`),
+			val2:   fwtypes.JSONValue(`{"b": 2, "a": 1}`),
+			equals: true,
+		},
+		"different values": {
+			val1: fwtypes.JSONValue(`{"a": 1}`),
+			val2: fwtypes.JSONValue(`{"a": 2}`),
+		},
+		"array order": {
+			val1: fwtypes.JSONValue(`[1, 2]`),
+			val2: fwtypes.JSONValue(`[2, 1]`),
+		},
+		"invalid JSON": {
+			val1: fwtypes.JSONValue(`{"a": 1}`),
+			val2: fwtypes.JSONValue(`{"a": `),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			equals, diags := test.val1.StringSemanticEquals(ctx, test.val2)
+
+			if diags.HasError() {
+				t.Fatalf("got unexpected error: %#v", diags)
+			}
+
+			if got, want := equals, test.equals; got != want {
+				t.Errorf("StringSemanticEquals(%s, %s) = %v, want %v", test.val1, test.val2, got, want)
+			}
+		})
+	}
+}
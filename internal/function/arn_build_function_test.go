@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestARNBuildFunction_known(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testARNBuildFunctionConfig("aws", "iam", "", "444455556666", "role/example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "arn:aws:iam::444455556666:role/example"),
+				),
+			},
+		},
+	})
+}
+
+func TestARNBuildFunction_roundTrip(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testARNBuildFunctionConfig_roundTrip("arn:aws-us-gov:s3:us-gov-west-1:123456789012:accesspoint/example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "arn:aws-us-gov:s3:us-gov-west-1:123456789012:accesspoint/example"),
+				),
+			},
+		},
+	})
+}
+
+func testARNBuildFunctionConfig(partition, service, region, accountID, resource string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::arn_build(%[1]q, %[2]q, %[3]q, %[4]q, %[5]q)
+}
+`, partition, service, region, accountID, resource)
+}
+
+func testARNBuildFunctionConfig_roundTrip(arn string) string {
+	return fmt.Sprintf(`
+locals {
+  parsed = provider::aws::arn_parse(%[1]q)
+}
+
+output "test" {
+  value = provider::aws::arn_build(local.parsed.partition, local.parsed.service, local.parsed.region, local.parsed.account_id, local.parsed.resource)
+}
+`, arn)
+}
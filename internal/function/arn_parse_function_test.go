@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestARNParseFunction_known(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testARNParseFunctionConfig("arn:aws:iam::444455556666:role/example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("partition", "aws"),
+					resource.TestCheckOutput("service", "iam"),
+					resource.TestCheckOutput("region", ""),
+					resource.TestCheckOutput("account_id", "444455556666"),
+					resource.TestCheckOutput("resource", "role/example"),
+				),
+			},
+		},
+	})
+}
+
+func TestARNParseFunction_invalid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testARNParseFunctionConfig("invalid"),
+				ExpectError: regexp.MustCompile("arn: invalid prefix"),
+			},
+		},
+	})
+}
+
+func testARNParseFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+locals {
+  parsed = provider::aws::arn_parse(%[1]q)
+}
+
+output "partition" {
+  value = local.parsed.partition
+}
+
+output "service" {
+  value = local.parsed.service
+}
+
+output "region" {
+  value = local.parsed.region
+}
+
+output "account_id" {
+  value = local.parsed.account_id
+}
+
+output "resource" {
+  value = local.parsed.resource
+}
+`, arg)
+}
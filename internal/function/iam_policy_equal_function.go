@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var _ function.Function = iamPolicyEqualFunction{}
+
+func NewIAMPolicyEqualFunction() function.Function {
+	return &iamPolicyEqualFunction{}
+}
+
+type iamPolicyEqualFunction struct{}
+
+func (f iamPolicyEqualFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_equal"
+}
+
+func (f iamPolicyEqualFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "iam_policy_equal Function",
+		MarkdownDescription: "Returns whether two IAM policy documents are semantically equivalent",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy1",
+				MarkdownDescription: "First IAM policy document to compare",
+			},
+			function.StringParameter{
+				Name:                "policy2",
+				MarkdownDescription: "Second IAM policy document to compare",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f iamPolicyEqualFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policy1, policy2 string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policy1, &policy2))
+	if resp.Error != nil {
+		return
+	}
+
+	for i, policy := range []string{policy1, policy2} {
+		if _, err := verify.PolicyCanonicalize(policy); err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(int64(i), err.Error()))
+		}
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := verify.PolicyStringsEquivalent(policy1, policy2)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyEqualFunction_equivalent(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyEqualFunctionConfig("s3:GetObject", "s3:PutObject"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyEqualFunction_different(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyEqualFunctionConfig("s3:GetObject", "s3:DeleteObject"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyEqualFunction_invalid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyEqualFunctionConfig_invalid(`{"Statement":`),
+				ExpectError: regexp.MustCompile(`Invalid value for "policy2" parameter`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyEqualFunctionConfig(action1, action2 string) string {
+	return fmt.Sprintf(`
+locals {
+  policy1 = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["s3:GetObject", "s3:PutObject"]
+      Resource = "*"
+    }]
+  })
+
+  policy2 = jsonencode({
+    Statement = {
+      Action   = [%[2]q, %[1]q]
+      Resource = ["*"]
+      Effect   = "Allow"
+    }
+    Version = "2012-10-17"
+  })
+}
+
+output "test" {
+  value = provider::aws::iam_policy_equal(local.policy1, local.policy2)
+}
+`, action1, action2)
+}
+
+func testIAMPolicyEqualFunctionConfig_invalid(policy string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_policy_equal("{}", %[1]q)
+}
+`, policy)
+}
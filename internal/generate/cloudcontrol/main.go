@@ -0,0 +1,579 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var (
+	schemasDir = flag.String("schemas", "schemas", "directory containing CloudFormation resource type schemas")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+type TemplateData struct {
+	CFTypeName          string
+	FactoryName         string
+	HumanFriendlyName   string
+	Imports             []string
+	PropertyNames       map[string]string
+	Schema              string
+	TagsAsList          bool
+	TagsProperty        string
+	TFTypeName          string
+	WriteOnlyAttributes []string
+}
+
+func main() {
+	g := common.NewGenerator()
+
+	flag.Usage = usage
+	flag.Parse()
+
+	filenames, err := filepath.Glob(filepath.Join(*schemasDir, "*.json"))
+
+	if err != nil {
+		g.Fatalf("listing schemas: %s", err)
+	}
+
+	for _, filename := range filenames {
+		templateData, err := generate(filename)
+
+		if err != nil {
+			g.Fatalf("generating resource from %s: %s", filename, err)
+		}
+
+		outputFilename := strings.TrimPrefix(templateData.TFTypeName, "aws_cloudcontrolapi_") + "_gen.go"
+
+		g.Infof("Generating internal/service/%s/%s", os.Getenv("GOPACKAGE"), outputFilename)
+
+		d := g.NewGoFileDestination(outputFilename)
+
+		if err := d.WriteTemplate("cloudcontrol", resourceTemplateBody, templateData); err != nil {
+			g.Fatalf("generating file (%s): %s", outputFilename, err)
+		}
+
+		if err := d.Write(); err != nil {
+			g.Fatalf("generating file (%s): %s", outputFilename, err)
+		}
+	}
+}
+
+// generate returns the template data for the CloudFormation resource type schema in the specified file.
+func generate(filename string) (*TemplateData, error) {
+	resourceSchema, err := cfschema.NewResourceJsonSchemaPath(filename)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading schema: %w", err)
+	}
+
+	resource, err := resourceSchema.Resource()
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing schema: %w", err)
+	}
+
+	if err := resource.Expand(); err != nil {
+		return nil, fmt.Errorf("expanding schema: %w", err)
+	}
+
+	cfTypeName := deref(resource.TypeName)
+	parts := strings.Split(cfTypeName, "::")
+
+	if len(parts) != 3 || parts[0] != "AWS" {
+		return nil, fmt.Errorf("unsupported type name: %q", cfTypeName)
+	}
+
+	g := &schemaGenerator{
+		propertyNames: make(map[string]string),
+		resource:      resource,
+	}
+
+	if v := resource.Tagging; v != nil && v.TagProperty != nil {
+		if path := v.TagProperty.Path(); len(path) == 1 {
+			g.tagsProperty = path[0]
+		}
+	} else if _, ok := resource.Properties["Tags"]; ok {
+		g.tagsProperty = "Tags"
+	}
+
+	if g.tagsProperty != "" {
+		property, ok := resource.Properties[g.tagsProperty]
+
+		if !ok {
+			return nil, fmt.Errorf("tag property %q not found", g.tagsProperty)
+		}
+
+		g.tagsAsList = property.Type.String() == cfschema.PropertyTypeArray
+	}
+
+	resourceName := toSnakeCase(parts[2])
+	schema, err := g.attributes(resource.Properties, resource.Required, "", resourceName, 3) //nolint:gomnd
+
+	if err != nil {
+		return nil, err
+	}
+
+	templateData := &TemplateData{
+		CFTypeName:          cfTypeName,
+		FactoryName:         fmt.Sprintf("newResource%s%s", parts[1], parts[2]),
+		HumanFriendlyName:   fmt.Sprintf("%s %s", parts[1], strings.Join(splitWords(parts[2]), " ")),
+		Imports:             imports(schema),
+		PropertyNames:       g.propertyNames,
+		Schema:              schema,
+		TagsAsList:          g.tagsAsList,
+		TagsProperty:        g.tagsProperty,
+		TFTypeName:          fmt.Sprintf("aws_cloudcontrolapi_%s_%s", toSnakeCase(parts[1]), resourceName),
+		WriteOnlyAttributes: g.writeOnlyAttributes,
+	}
+
+	return templateData, nil
+}
+
+// optionalImports are the packages that may be referenced by generated schema attributes.
+var optionalImports = map[string]string{
+	"attr":                `"github.com/hashicorp/terraform-plugin-framework/attr"`,
+	"boolplanmodifier":    `"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"`,
+	"float64planmodifier": `"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"`,
+	"fwtypes":             `fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"`,
+	"int64planmodifier":   `"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"`,
+	"listplanmodifier":    `"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"`,
+	"mapplanmodifier":     `"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"`,
+	"objectplanmodifier":  `"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"`,
+	"planmodifier":        `"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"`,
+	"setplanmodifier":     `"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"`,
+	"stringplanmodifier":  `"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"`,
+	"tftags":              `tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"`,
+	"types":               `"github.com/hashicorp/terraform-plugin-framework/types"`,
+}
+
+// imports returns the optional imports referenced by the specified Go source.
+func imports(source string) []string {
+	var imports []string
+
+	for name, path := range optionalImports {
+		if regexp.MustCompile(`\b` + name + `\.`).MatchString(source) {
+			imports = append(imports, path)
+		}
+	}
+
+	// The generated source is formatted, which sorts imports by path.
+	sort.Strings(imports)
+
+	return imports
+}
+
+// reservedAttributeNames are the top-level attribute names that cannot be used for resource properties.
+var reservedAttributeNames = []string{
+	"count",
+	"depends_on",
+	"for_each",
+	"lifecycle",
+	"provider",
+	names.AttrID,
+	names.AttrRegion,
+	names.AttrTagsAll,
+	names.AttrTimeouts,
+}
+
+type schemaGenerator struct {
+	propertyNames       map[string]string
+	resource            *cfschema.Resource
+	tagsAsList          bool
+	tagsProperty        string
+	writeOnlyAttributes []string
+}
+
+// attributes returns the Go source for the schema attributes corresponding to the specified properties.
+// path is the properties' JSON Pointer path below /properties.
+func (g *schemaGenerator) attributes(properties map[string]*cfschema.Property, required []string, path, resourceName string, indent int) (string, error) {
+	topLevel := path == ""
+	propertyNames := make([]string, 0, len(properties))
+	for name := range properties {
+		propertyNames = append(propertyNames, name)
+	}
+	sort.Strings(propertyNames)
+
+	attributes := make(map[string]string)
+
+	if topLevel {
+		attributes[names.AttrID] = "framework.IDAttribute()"
+	}
+
+	for _, propertyName := range propertyNames {
+		property := properties[propertyName]
+		propertyPath := path + "/" + propertyName
+		attributeName := toSnakeCase(propertyName)
+
+		if topLevel && propertyName == g.tagsProperty {
+			attributes[names.AttrTags] = "tftags.TagsAttribute()"
+			attributes[names.AttrTagsAll] = "tftags.TagsAttributeComputedOnly()"
+			g.propertyNames[names.AttrTags] = propertyName
+
+			continue
+		}
+
+		if topLevel && contains(reservedAttributeNames, attributeName) {
+			attributeName = resourceName + "_" + attributeName
+		}
+
+		if v, ok := g.propertyNames[attributeName]; ok && v != propertyName {
+			return "", fmt.Errorf("properties %q and %q have the same attribute name (%s)", v, propertyName, attributeName)
+		}
+		g.propertyNames[attributeName] = propertyName
+
+		if topLevel && isPropertyPath(g.resource.WriteOnlyProperties, propertyPath) {
+			g.writeOnlyAttributes = append(g.writeOnlyAttributes, attributeName)
+		}
+
+		attribute, err := g.attribute(property, contains(required, propertyName), propertyPath, resourceName, indent)
+
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", propertyPath, err)
+		}
+
+		attributes[attributeName] = attribute
+	}
+
+	attributeNames := make([]string, 0, len(attributes))
+	for name := range attributes {
+		attributeNames = append(attributeNames, name)
+	}
+	sort.Strings(attributeNames)
+
+	var b strings.Builder
+
+	b.WriteString("map[string]schema.Attribute{\n")
+	for _, name := range attributeNames {
+		fmt.Fprintf(&b, "%s%q: %s,\n", tabs(indent), name, attributes[name])
+	}
+	fmt.Fprintf(&b, "%s}", tabs(indent-1))
+
+	return b.String(), nil
+}
+
+// attribute returns the Go source for the schema attribute corresponding to the specified property.
+func (g *schemaGenerator) attribute(property *cfschema.Property, required bool, path, resourceName string, indent int) (string, error) {
+	readOnly := isPropertyPath(g.resource.ReadOnlyProperties, path)
+	// Nested properties have no attributes of their own, so any create-only nested property replaces the whole attribute.
+	createOnly := isPropertyPath(g.resource.CreateOnlyProperties, path) || hasPropertyPathPrefix(g.resource.CreateOnlyProperties, path+"/")
+	topLevel := strings.Count(path, "/") == 1
+
+	var fields []string
+	var kind, modifierType string
+
+	switch propertyType := property.Type.String(); propertyType {
+	case cfschema.PropertyTypeString:
+		kind, modifierType = "String", "string"
+
+	case cfschema.PropertyTypeInteger:
+		kind, modifierType = "Int64", "int64"
+
+	case cfschema.PropertyTypeNumber:
+		kind, modifierType = "Float64", "float64"
+
+	case cfschema.PropertyTypeBoolean:
+		kind, modifierType = "Bool", "bool"
+
+	case cfschema.PropertyTypeArray:
+		if property.Items == nil {
+			return "", fmt.Errorf("array has no items")
+		}
+
+		modifierType = "list"
+		if !deref(property.InsertionOrder) {
+			modifierType = "set"
+		}
+		kind = strings.Title(modifierType) //nolint:staticcheck // Only ASCII
+
+		if property.Items.Type.String() == cfschema.PropertyTypeObject && len(property.Items.Properties) > 0 {
+			// Nested attributes aren't supported over protocol version 5, so objects are represented by object types.
+			attributeTypes, err := g.attributeTypes(property.Items.Properties, path+"/*", indent+2) //nolint:gomnd
+
+			if err != nil {
+				return "", err
+			}
+
+			fields = append(fields, fmt.Sprintf("ElementType: types.ObjectType{\n%sAttrTypes: %s,\n%s}", tabs(indent+1), attributeTypes, tabs(indent)))
+		} else {
+			elementType, err := elementType(property.Items)
+
+			if err != nil {
+				return "", err
+			}
+
+			fields = append(fields, "ElementType: "+elementType)
+		}
+
+	case cfschema.PropertyTypeObject:
+		switch {
+		case len(property.Properties) > 0:
+			attributeTypes, err := g.attributeTypes(property.Properties, path, indent+1)
+
+			if err != nil {
+				return "", err
+			}
+
+			kind, modifierType = "Object", "object"
+			fields = append(fields, "AttributeTypes: "+attributeTypes)
+
+		case len(property.PatternProperties) > 0:
+			elementType, err := patternPropertiesElementType(property.PatternProperties)
+
+			if err != nil {
+				return "", err
+			}
+
+			kind, modifierType = "Map", "map"
+			fields = append(fields, "ElementType: "+elementType)
+
+		default:
+			// Arbitrary JSON.
+			kind, modifierType = "String", "string"
+			fields = append(fields, "CustomType: fwtypes.JSONType")
+		}
+
+	default:
+		return "", fmt.Errorf("unsupported type: %q", propertyType)
+	}
+
+	switch {
+	case required && !readOnly:
+		fields = append(fields, "Required: true")
+	case readOnly:
+		fields = append(fields, "Computed: true")
+	default:
+		fields = append(fields, "Optional: true", "Computed: true")
+	}
+
+	if v := deref(property.Description); v != "" {
+		fields = append(fields, fmt.Sprintf("Description: %q", v))
+	}
+
+	var planModifiers []string
+
+	if createOnly {
+		planModifiers = append(planModifiers, fmt.Sprintf("%splanmodifier.RequiresReplace()", modifierType))
+	}
+
+	if topLevel && (readOnly || !required) {
+		planModifiers = append(planModifiers, fmt.Sprintf("%splanmodifier.UseStateForUnknown()", modifierType))
+	}
+
+	if len(planModifiers) > 0 {
+		var b strings.Builder
+
+		fmt.Fprintf(&b, "PlanModifiers: []planmodifier.%s{\n", strings.Title(modifierType)) //nolint:staticcheck // Only ASCII
+		for _, v := range planModifiers {
+			fmt.Fprintf(&b, "%s%s,\n", tabs(indent+1), v)
+		}
+		fmt.Fprintf(&b, "%s}", tabs(indent))
+
+		fields = append(fields, b.String())
+	}
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "schema.%sAttribute{\n", kind)
+	for _, v := range fields {
+		fmt.Fprintf(&b, "%s%s,\n", tabs(indent), v)
+	}
+	fmt.Fprintf(&b, "%s}", tabs(indent-1))
+
+	return b.String(), nil
+}
+
+// attributeTypes returns the Go source for the framework attribute types corresponding to the specified object properties.
+// path is the properties' JSON Pointer path below /properties.
+func (g *schemaGenerator) attributeTypes(properties map[string]*cfschema.Property, path string, indent int) (string, error) {
+	propertyNames := make([]string, 0, len(properties))
+	for name := range properties {
+		propertyNames = append(propertyNames, name)
+	}
+	sort.Strings(propertyNames)
+
+	var b strings.Builder
+
+	b.WriteString("map[string]attr.Type{\n")
+	for _, propertyName := range propertyNames {
+		propertyPath := path + "/" + propertyName
+		attributeName := toSnakeCase(propertyName)
+
+		if v, ok := g.propertyNames[attributeName]; ok && v != propertyName {
+			return "", fmt.Errorf("properties %q and %q have the same attribute name (%s)", v, propertyName, attributeName)
+		}
+		g.propertyNames[attributeName] = propertyName
+
+		attributeType, err := g.attributeType(properties[propertyName], propertyPath, indent+1)
+
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", propertyPath, err)
+		}
+
+		fmt.Fprintf(&b, "%s%q: %s,\n", tabs(indent), attributeName, attributeType)
+	}
+	fmt.Fprintf(&b, "%s}", tabs(indent-1))
+
+	return b.String(), nil
+}
+
+// attributeType returns the Go source for the framework attribute type corresponding to the specified nested property.
+func (g *schemaGenerator) attributeType(property *cfschema.Property, path string, indent int) (string, error) {
+	switch propertyType := property.Type.String(); propertyType {
+	case cfschema.PropertyTypeArray:
+		if property.Items == nil {
+			return "", fmt.Errorf("array has no items")
+		}
+
+		elementType, err := g.attributeType(property.Items, path+"/*", indent)
+
+		if err != nil {
+			return "", err
+		}
+
+		if deref(property.InsertionOrder) {
+			return fmt.Sprintf("types.ListType{ElemType: %s}", elementType), nil
+		}
+
+		return fmt.Sprintf("types.SetType{ElemType: %s}", elementType), nil
+
+	case cfschema.PropertyTypeObject:
+		switch {
+		case len(property.Properties) > 0:
+			attributeTypes, err := g.attributeTypes(property.Properties, path, indent+1)
+
+			if err != nil {
+				return "", err
+			}
+
+			return fmt.Sprintf("types.ObjectType{\n%sAttrTypes: %s,\n%s}", tabs(indent), attributeTypes, tabs(indent-1)), nil
+
+		case len(property.PatternProperties) > 0:
+			elementType, err := patternPropertiesElementType(property.PatternProperties)
+
+			if err != nil {
+				return "", err
+			}
+
+			return fmt.Sprintf("types.MapType{ElemType: %s}", elementType), nil
+		}
+	}
+
+	return elementType(property)
+}
+
+// elementType returns the Go source for the framework type of the specified primitive or JSON property.
+func elementType(property *cfschema.Property) (string, error) {
+	switch propertyType := property.Type.String(); propertyType {
+	case cfschema.PropertyTypeString:
+		return "types.StringType", nil
+	case cfschema.PropertyTypeInteger:
+		return "types.Int64Type", nil
+	case cfschema.PropertyTypeNumber:
+		return "types.Float64Type", nil
+	case cfschema.PropertyTypeBoolean:
+		return "types.BoolType", nil
+	case cfschema.PropertyTypeObject:
+		return "fwtypes.JSONType", nil
+	default:
+		return "", fmt.Errorf("unsupported element type: %q", propertyType)
+	}
+}
+
+// patternPropertiesElementType returns the Go source for the framework type of a map's elements.
+func patternPropertiesElementType(patternProperties map[string]*cfschema.Property) (string, error) {
+	var t string
+
+	for _, v := range patternProperties {
+		elementType, err := elementType(v)
+
+		if err != nil {
+			return "", err
+		}
+
+		if t != "" && t != elementType {
+			return "", fmt.Errorf("pattern properties have different types")
+		}
+
+		t = elementType
+	}
+
+	return t, nil
+}
+
+func isPropertyPath(pointers cfschema.PropertyJsonPointers, path string) bool {
+	for _, v := range pointers {
+		if v.EqualsStringPath(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasPropertyPathPrefix(pointers cfschema.PropertyJsonPointers, prefix string) bool {
+	for _, v := range pointers {
+		if strings.HasPrefix(strings.TrimPrefix(v.String(), cfschema.PropertiesJsonPointerPrefix), prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func deref[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+
+	return *v
+}
+
+func tabs(n int) string {
+	return strings.Repeat("\t", n)
+}
+
+func toSnakeCase(str string) string {
+	result := regexp.MustCompile("(.)([A-Z][a-z]+)").ReplaceAllString(str, "${1}_${2}")
+	result = regexp.MustCompile("([a-z0-9])([A-Z])").ReplaceAllString(result, "${1}_${2}")
+	return strings.ToLower(result)
+}
+
+func splitWords(str string) []string {
+	return strings.Split(regexp.MustCompile("([a-z0-9])([A-Z])").ReplaceAllString(str, "${1} ${2}"), " ")
+}
+
+//go:embed resource.tmpl
+var resourceTemplateBody string
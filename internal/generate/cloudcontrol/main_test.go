@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testSchema = `{
+  "typeName": "AWS::Example::Widget",
+  "description": "Example resource with object properties.",
+  "additionalProperties": false,
+  "properties": {
+    "Arn": {
+      "type": "string"
+    },
+    "Name": {
+      "type": "string"
+    },
+    "Settings": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "Enabled": {
+          "type": "boolean"
+        },
+        "Limits": {
+          "type": "object",
+          "additionalProperties": false,
+          "properties": {
+            "Maximum": {
+              "type": "integer"
+            }
+          }
+        },
+        "Labels": {
+          "type": "object",
+          "patternProperties": {
+            "^.+$": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "Rules": {
+      "type": "array",
+      "insertionOrder": true,
+      "items": {
+        "type": "object",
+        "additionalProperties": false,
+        "properties": {
+          "Action": {
+            "type": "string"
+          },
+          "Values": {
+            "type": "array",
+            "insertionOrder": false,
+            "items": {
+              "type": "number"
+            }
+          }
+        }
+      }
+    },
+    "Targets": {
+      "type": "array",
+      "insertionOrder": false,
+      "items": {
+        "type": "object",
+        "additionalProperties": false,
+        "properties": {
+          "TargetId": {
+            "type": "string"
+          }
+        }
+      }
+    }
+  },
+  "required": [
+    "Name"
+  ],
+  "createOnlyProperties": [
+    "/properties/Name",
+    "/properties/Settings/Limits/Maximum"
+  ],
+  "readOnlyProperties": [
+    "/properties/Arn"
+  ],
+  "primaryIdentifier": [
+    "/properties/Arn"
+  ]
+}`
+
+func TestGenerateObjectProperties(t *testing.T) {
+	t.Parallel()
+
+	filename := filepath.Join(t.TempDir(), "AWS_Example_Widget.json")
+
+	if err := os.WriteFile(filename, []byte(testSchema), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	templateData, err := generate(filename)
+
+	if err != nil {
+		t.Fatalf("generating resource: %s", err)
+	}
+
+	// Nested attributes aren't supported over protocol version 5.
+	if strings.Contains(templateData.Schema, "Nested") {
+		t.Errorf("schema contains nested attributes:\n%s", templateData.Schema)
+	}
+
+	for _, want := range []string{
+		`"settings": schema.ObjectAttribute{`,
+		`"limits": types.ObjectType{`,
+		`"labels": types.MapType{ElemType: types.StringType}`,
+		`"rules": schema.ListAttribute{`,
+		`"values": types.SetType{ElemType: types.Float64Type}`,
+		`"targets": schema.SetAttribute{`,
+		`objectplanmodifier.RequiresReplace()`,
+	} {
+		if !strings.Contains(templateData.Schema, want) {
+			t.Errorf("schema does not contain %q:\n%s", want, templateData.Schema)
+		}
+	}
+
+	for attributeName, propertyName := range map[string]string{
+		"maximum":   "Maximum",
+		"target_id": "TargetId",
+		"values":    "Values",
+	} {
+		if got := templateData.PropertyNames[attributeName]; got != propertyName {
+			t.Errorf("property name for %q = %q, want %q", attributeName, got, propertyName)
+		}
+	}
+
+	typeCheck(t, templateData)
+}
+
+// typeCheck type-checks the generated schema.
+// The source is written below this directory so that the provider's internal packages can be imported.
+func typeCheck(t *testing.T, templateData *TemplateData) {
+	t.Helper()
+
+	if testing.Short() {
+		t.Skip("skipping type check in short mode")
+	}
+
+	dir, err := os.MkdirTemp(".", "typecheck")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	var b strings.Builder
+
+	b.WriteString("package typecheck\n\nimport (\n")
+	b.WriteString("\t\"github.com/hashicorp/terraform-plugin-framework/resource/schema\"\n")
+	for _, v := range templateData.Imports {
+		fmt.Fprintf(&b, "\t%s\n", v)
+	}
+	b.WriteString("\t\"github.com/hashicorp/terraform-provider-aws/internal/framework\"\n")
+	b.WriteString(")\n\n")
+	fmt.Fprintf(&b, "var _ = schema.Schema{\n\tAttributes: %s,\n}\n", templateData.Schema)
+
+	if err := os.WriteFile(filepath.Join(dir, "schema.go"), []byte(b.String()), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command("go", "vet", "./"+filepath.Base(dir))
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("type checking generated schema: %s\n%s\n%s", err, output, b.String())
+	}
+}
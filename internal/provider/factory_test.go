@@ -7,9 +7,94 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// TestProtoV5ProviderServerFactory_GetProviderSchema verifies that every resource and data source schema,
+// including those of Terraform Plugin Framework resources, can be served over protocol version 5.
+func TestProtoV5ProviderServerFactory_GetProviderSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	factory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response, err := factory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, d := range response.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Errorf("%s: %s", d.Summary, d.Detail)
+		}
+	}
+}
+
+// TestProtoV5ProviderServerFactory_regionAttribute verifies that a `region` attribute is added only to
+// the resources and data sources of Regional services.
+func TestProtoV5ProviderServerFactory_regionAttribute(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	factory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response, err := factory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hasRegion := func(s *tfprotov5.Schema) bool {
+		for _, v := range s.Block.Attributes {
+			if v.Name == names.AttrRegion {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	testCases := []struct {
+		typeName     string
+		isDataSource bool
+		expected     bool
+	}{
+		{typeName: "aws_vpc", expected: true},
+		{typeName: "aws_vpc", isDataSource: true, expected: true},
+		{typeName: "aws_iam_role", expected: false},
+		{typeName: "aws_iam_role", isDataSource: true, expected: false},
+		{typeName: "aws_organizations_organization", expected: false},
+		{typeName: "aws_route53_cidr_collection", expected: false}, // Plugin Framework.
+	}
+
+	for _, testCase := range testCases {
+		schemas := response.ResourceSchemas
+		if testCase.isDataSource {
+			schemas = response.DataSourceSchemas
+		}
+
+		s, ok := schemas[testCase.typeName]
+		if !ok {
+			t.Fatalf("%s: schema not found", testCase.typeName)
+		}
+
+		if got, want := hasRegion(s), testCase.expected; got != want {
+			t.Errorf("%s (data source: %t): has region attribute = %t, want %t", testCase.typeName, testCase.isDataSource, got, want)
+		}
+	}
+}
+
 // go test -bench=BenchmarkProtoV5ProviderServerFactory -benchtime 1x -benchmem -run=B -v ./internal/provider
 func BenchmarkProtoV5ProviderServerFactory(b *testing.B) {
 	_, p, err := provider.ProtoV5ProviderServerFactory(context.Background())
@@ -6,17 +6,24 @@ package fwprovider
 import (
 	"context"
 	"fmt"
+	"maps"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -143,17 +150,42 @@ type contextFunc func(context.Context, *conns.AWSClient) context.Context
 type wrappedDataSource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
-	inner            datasource.DataSourceWithConfigure
-	interceptors     dataSourceInterceptors
-	meta             *conns.AWSClient
+	// factory creates new instances of the inner data source.
+	factory      func(context.Context) (datasource.DataSourceWithConfigure, error)
+	inner        datasource.DataSourceWithConfigure
+	interceptors dataSourceInterceptors
+	meta         *conns.AWSClient
+	// regional is true if a `region` attribute has been added to the inner data source's schema.
+	regional    bool
+	innerSchema dsschema.Schema
+	schema      dsschema.Schema
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors) datasource.DataSourceWithConfigure {
-	return &wrappedDataSource{
+func newWrappedDataSource(bootstrapContext contextFunc, factory func(context.Context) (datasource.DataSourceWithConfigure, error), inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors, innerSchema dsschema.Schema, global bool) datasource.DataSourceWithConfigure {
+	w := &wrappedDataSource{
 		bootstrapContext: bootstrapContext,
+		factory:          factory,
 		inner:            inner,
 		interceptors:     interceptors,
+		innerSchema:      innerSchema,
+		schema:           innerSchema,
+	}
+
+	// Data sources in global services have no `region` attribute.
+	if _, ok := innerSchema.Attributes[names.AttrRegion]; !ok && !global {
+		attributes := make(map[string]dsschema.Attribute, len(innerSchema.Attributes)+1)
+		maps.Copy(attributes, innerSchema.Attributes)
+		attributes[names.AttrRegion] = dsschema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: regionAttributeDescription,
+		}
+
+		w.regional = true
+		w.schema.Attributes = attributes
 	}
+
+	return w
 }
 
 func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
@@ -164,15 +196,131 @@ func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.Met
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.regional && !response.Diagnostics.HasError() {
+		response.Schema = w.schema
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
+
+	if !w.regional {
+		// TODO Run interceptors.
+		w.inner.Read(ctx, request, response)
+
+		return
+	}
+
+	region, diags := regionFrom(ctx, request.Config, request.Config.Raw)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	meta, diags := regionalMeta(ctx, w.meta, region)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(w.configureRegional(ctx, meta)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	innerRequest := request
+	innerResponse := *response
+	var err error
+
+	if innerRequest.Config.Raw, err = withoutRegion(ctx, request.Config.Raw, w.innerSchema); err != nil {
+		response.Diagnostics.Append(regionError(err))
+		return
+	}
+	innerRequest.Config.Schema = w.innerSchema
+
+	if innerResponse.State.Raw, err = withoutRegion(ctx, response.State.Raw, w.innerSchema); err != nil {
+		response.Diagnostics.Append(regionError(err))
+		return
+	}
+	innerResponse.State.Schema = w.innerSchema
+
 	// TODO Run interceptors.
-	w.inner.Read(ctx, request, response)
+	w.inner.Read(ctx, innerRequest, &innerResponse)
+
+	*response = innerResponse
+	response.State.Schema = w.schema
+	if response.State.Raw, err = withRegion(ctx, innerResponse.State.Raw, w.schema, regionValue(meta)); err != nil {
+		response.Diagnostics.Append(regionError(err))
+	}
 }
 
 func (w *wrappedDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Configure(ctx, request, response)
+}
+
+// configureRegional replaces the inner data source with a new instance configured with the specified Region's instance data,
+// so that the data source's Meta() returns that Region's API clients, Region and DNS suffix.
+// The inner data source configured with the provider's instance data is shared, but each request has its own wrapper.
+func (w *wrappedDataSource) configureRegional(ctx context.Context, meta *conns.AWSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if meta == nil || meta == w.meta {
+		return diags
+	}
+
+	inner, err := w.factory(ctx)
+	if err != nil {
+		diags.AddError("Region", fmt.Sprintf("creating data source for Region (%s): %s", meta.Region, err))
+
+		return diags
+	}
+
+	response := datasource.ConfigureResponse{}
+	inner.Configure(ctx, datasource.ConfigureRequest{ProviderData: meta}, &response)
+	w.inner = inner
+
+	return response.Diagnostics
+}
+
+// wrappedEphemeralResource represents an interceptor dispatcher for a Plugin Framework ephemeral resource.
+type wrappedEphemeralResource struct {
+	// bootstrapContext is run on all wrapped methods before any interceptors.
+	bootstrapContext contextFunc
+	inner            ephemeral.EphemeralResourceWithConfigure
+	meta             *conns.AWSClient
+}
+
+func newWrappedEphemeralResource(bootstrapContext contextFunc, inner ephemeral.EphemeralResourceWithConfigure) ephemeral.EphemeralResourceWithConfigure {
+	return &wrappedEphemeralResource{
+		bootstrapContext: bootstrapContext,
+		inner:            inner,
+	}
+}
+
+func (w *wrappedEphemeralResource) Metadata(ctx context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Metadata(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Schema(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Open(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Configure(ctx context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Configure(ctx, request, response)
 }
@@ -191,17 +339,42 @@ func (r tagsDataSourceInterceptor) read(ctx context.Context, request datasource.
 type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
-	inner            resource.ResourceWithConfigure
-	interceptors     resourceInterceptors
-	meta             *conns.AWSClient
+	// factory creates new instances of the inner resource.
+	factory      func(context.Context) (resource.ResourceWithConfigure, error)
+	inner        resource.ResourceWithConfigure
+	interceptors resourceInterceptors
+	meta         *conns.AWSClient
+	// regional is true if a `region` attribute has been added to the inner resource's schema.
+	regional    bool
+	innerSchema rschema.Schema
+	schema      rschema.Schema
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
-	return &wrappedResource{
+func newWrappedResource(bootstrapContext contextFunc, factory func(context.Context) (resource.ResourceWithConfigure, error), inner resource.ResourceWithConfigure, interceptors resourceInterceptors, innerSchema rschema.Schema, global bool) resource.ResourceWithConfigure {
+	w := &wrappedResource{
 		bootstrapContext: bootstrapContext,
+		factory:          factory,
 		inner:            inner,
 		interceptors:     interceptors,
+		innerSchema:      innerSchema,
+		schema:           innerSchema,
 	}
+
+	// Resources in global services have no `region` attribute.
+	if _, ok := innerSchema.Attributes[names.AttrRegion]; !ok && !global {
+		attributes := make(map[string]rschema.Attribute, len(innerSchema.Attributes)+1)
+		maps.Copy(attributes, innerSchema.Attributes)
+		attributes[names.AttrRegion] = rschema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: regionAttributeDescription,
+		}
+
+		w.regional = true
+		w.schema.Attributes = attributes
+	}
+
+	return w
 }
 
 func (w *wrappedResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -212,6 +385,10 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.regional && !response.Diagnostics.HasError() {
+		response.Schema = w.schema
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -220,8 +397,44 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedHandler(w.interceptors.create(), f, w.meta)(ctx, request, response)
+
+	if !w.regional {
+		diags := interceptedHandler(w.interceptors.create(), f, w.meta)(ctx, request, response)
+		response.Diagnostics = diags
+
+		return
+	}
+
+	meta, diags := w.regionalMeta(ctx, request.Plan, request.Plan.Raw)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	innerRequest := request
+	innerResponse := *response
+	var err error
+
+	if innerRequest.Config, err = w.innerConfig(ctx, request.Config); err != nil {
+		response.Diagnostics.Append(regionError(err))
+		return
+	}
+	if innerRequest.Plan, err = w.innerPlan(ctx, request.Plan); err != nil {
+		response.Diagnostics.Append(regionError(err))
+		return
+	}
+	if innerResponse.State, err = w.innerState(ctx, response.State); err != nil {
+		response.Diagnostics.Append(regionError(err))
+		return
+	}
+
+	diags = interceptedHandler(w.interceptors.create(), f, meta)(ctx, innerRequest, &innerResponse)
+
+	*response = innerResponse
 	response.Diagnostics = diags
+	if response.State, err = w.outerState(ctx, innerResponse.State, regionValue(meta)); err != nil {
+		response.Diagnostics.Append(regionError(err))
+	}
 }
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -230,8 +443,41 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
+
+	if !w.regional {
+		diags := interceptedHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
+		response.Diagnostics = diags
+
+		return
+	}
+
+	// Resources in state from before the `region` attribute was added are in the provider's configured Region.
+	meta, diags := w.regionalMeta(ctx, request.State, request.State.Raw)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	innerRequest := request
+	innerResponse := *response
+	var err error
+
+	if innerRequest.State, err = w.innerState(ctx, request.State); err != nil {
+		response.Diagnostics.Append(regionError(err))
+		return
+	}
+	if innerResponse.State, err = w.innerState(ctx, response.State); err != nil {
+		response.Diagnostics.Append(regionError(err))
+		return
+	}
+
+	diags = interceptedHandler(w.interceptors.read(), f, meta)(ctx, innerRequest, &innerResponse)
+
+	*response = innerResponse
 	response.Diagnostics = diags
+	if response.State, err = w.outerState(ctx, innerResponse.State, regionValue(meta)); err != nil {
+		response.Diagnostics.Append(regionError(err))
+	}
 }
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
@@ -240,8 +486,48 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedHandler(w.interceptors.update(), f, w.meta)(ctx, request, response)
+
+	if !w.regional {
+		diags := interceptedHandler(w.interceptors.update(), f, w.meta)(ctx, request, response)
+		response.Diagnostics = diags
+
+		return
+	}
+
+	meta, diags := w.regionalMeta(ctx, request.Plan, request.Plan.Raw)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	innerRequest := request
+	innerResponse := *response
+	var err error
+
+	if innerRequest.Config, err = w.innerConfig(ctx, request.Config); err != nil {
+		response.Diagnostics.Append(regionError(err))
+		return
+	}
+	if innerRequest.Plan, err = w.innerPlan(ctx, request.Plan); err != nil {
+		response.Diagnostics.Append(regionError(err))
+		return
+	}
+	if innerRequest.State, err = w.innerState(ctx, request.State); err != nil {
+		response.Diagnostics.Append(regionError(err))
+		return
+	}
+	if innerResponse.State, err = w.innerState(ctx, response.State); err != nil {
+		response.Diagnostics.Append(regionError(err))
+		return
+	}
+
+	diags = interceptedHandler(w.interceptors.update(), f, meta)(ctx, innerRequest, &innerResponse)
+
+	*response = innerResponse
 	response.Diagnostics = diags
+	if response.State, err = w.outerState(ctx, innerResponse.State, regionValue(meta)); err != nil {
+		response.Diagnostics.Append(regionError(err))
+	}
 }
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
@@ -250,8 +536,40 @@ func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedHandler(w.interceptors.delete(), f, w.meta)(ctx, request, response)
+
+	if !w.regional {
+		diags := interceptedHandler(w.interceptors.delete(), f, w.meta)(ctx, request, response)
+		response.Diagnostics = diags
+
+		return
+	}
+
+	meta, diags := w.regionalMeta(ctx, request.State, request.State.Raw)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	innerRequest := request
+	innerResponse := *response
+	var err error
+
+	if innerRequest.State, err = w.innerState(ctx, request.State); err != nil {
+		response.Diagnostics.Append(regionError(err))
+		return
+	}
+	if innerResponse.State, err = w.innerState(ctx, response.State); err != nil {
+		response.Diagnostics.Append(regionError(err))
+		return
+	}
+
+	diags = interceptedHandler(w.interceptors.delete(), f, meta)(ctx, innerRequest, &innerResponse)
+
+	*response = innerResponse
 	response.Diagnostics = diags
+	if response.State, err = w.outerState(ctx, innerResponse.State, regionValue(meta)); err != nil {
+		response.Diagnostics.Append(regionError(err))
+	}
 }
 
 func (w *wrappedResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
@@ -262,10 +580,73 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 	w.inner.Configure(ctx, request, response)
 }
 
+// configureRegional replaces the inner resource with a new instance configured with the specified Region's instance data,
+// so that the resource's Meta() returns that Region's API clients, Region and DNS suffix.
+// The inner resource configured with the provider's instance data is shared, but each request has its own wrapper.
+func (w *wrappedResource) configureRegional(ctx context.Context, meta *conns.AWSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if meta == nil || meta == w.meta {
+		return diags
+	}
+
+	inner, err := w.factory(ctx)
+	if err != nil {
+		diags.AddError("Region", fmt.Sprintf("creating resource for Region (%s): %s", meta.Region, err))
+
+		return diags
+	}
+
+	response := resource.ConfigureResponse{}
+	inner.Configure(ctx, resource.ConfigureRequest{ProviderData: meta}, &response)
+	w.inner = inner
+
+	return response.Diagnostics
+}
+
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ImportState(ctx, request, response)
+
+		if !w.regional {
+			v.ImportState(ctx, request, response)
+
+			return
+		}
+
+		// Import IDs can be suffixed with the resource's Region, e.g. `id@us-west-2`.
+		region := fwtypes.StringNull()
+		if id, r, ok := conns.SplitRegionalImportID(request.ID); ok {
+			request.ID = id
+			region = fwtypes.StringValue(r)
+		}
+
+		meta, diags := regionalMeta(ctx, w.meta, region)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		response.Diagnostics.Append(w.configureRegional(ctx, meta)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		v = w.inner.(resource.ResourceWithImportState)
+
+		innerResponse := *response
+		var err error
+
+		if innerResponse.State, err = w.innerState(ctx, response.State); err != nil {
+			response.Diagnostics.Append(regionError(err))
+			return
+		}
+
+		v.ImportState(ctx, request, &innerResponse)
+
+		*response = innerResponse
+		if response.State, err = w.outerState(ctx, innerResponse.State, regionValue(meta)); err != nil {
+			response.Diagnostics.Append(regionError(err))
+		}
 
 		return
 	}
@@ -277,12 +658,109 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ModifyPlan(ctx, request, response)
+	if !w.regional {
+		if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
+			ctx = w.bootstrapContext(ctx, w.meta)
+			v.ModifyPlan(ctx, request, response)
+
+			return
+		}
 
 		return
 	}
+
+	ctx = w.bootstrapContext(ctx, w.meta)
+
+	// If the configured Region is unknown, so is the planned Region.
+	// If no Region is configured, the provider's configured Region is used.
+	var planRegion tftypes.Value
+	if !request.Plan.Raw.IsNull() {
+		region, diags := regionFrom(ctx, request.Config, request.Config.Raw)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		switch {
+		case region.IsUnknown():
+			planRegion = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+		case region.IsNull():
+			planRegion = regionValue(w.meta)
+		default:
+			planRegion = tftypes.NewValue(tftypes.String, region.ValueString())
+		}
+
+		// Sets any Region override in Context for the inner resource's plan modification.
+		meta, diags := regionalMeta(ctx, w.meta, region)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		response.Diagnostics.Append(w.configureRegional(ctx, meta)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Resources are always replaced when their Region changes.
+		if !request.State.Raw.IsNull() {
+			region, diags := regionFrom(ctx, request.State, request.State.Raw)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			if !region.IsNull() && !planRegion.Equal(tftypes.NewValue(tftypes.String, region.ValueString())) {
+				response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRegion))
+			}
+		}
+	}
+
+	v, ok := w.inner.(resource.ResourceWithModifyPlan)
+	if !ok {
+		if !request.Plan.Raw.IsNull() {
+			plan, err := w.outerPlan(ctx, response.Plan, planRegion)
+			if err != nil {
+				response.Diagnostics.Append(regionError(err))
+				return
+			}
+			response.Plan = plan
+		}
+
+		return
+	}
+
+	innerRequest := request
+	innerResponse := *response
+	var err error
+
+	if innerRequest.Config, err = w.innerConfig(ctx, request.Config); err != nil {
+		response.Diagnostics.Append(regionError(err))
+		return
+	}
+	if innerRequest.Plan, err = w.innerPlan(ctx, request.Plan); err != nil {
+		response.Diagnostics.Append(regionError(err))
+		return
+	}
+	if innerRequest.State, err = w.innerState(ctx, request.State); err != nil {
+		response.Diagnostics.Append(regionError(err))
+		return
+	}
+	if innerResponse.Plan, err = w.innerPlan(ctx, response.Plan); err != nil {
+		response.Diagnostics.Append(regionError(err))
+		return
+	}
+
+	v.ModifyPlan(ctx, innerRequest, &innerResponse)
+
+	*response = innerResponse
+	if !request.Plan.Raw.IsNull() {
+		if response.Plan, err = w.outerPlan(ctx, innerResponse.Plan, planRegion); err != nil {
+			response.Diagnostics.Append(regionError(err))
+		}
+	} else {
+		response.Plan = request.Plan
+	}
 }
 
 func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -297,6 +775,16 @@ func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.Confi
 func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
 	if v, ok := w.inner.(resource.ResourceWithValidateConfig); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+
+		if w.regional {
+			var err error
+
+			if request.Config, err = w.innerConfig(ctx, request.Config); err != nil {
+				response.Diagnostics.Append(regionError(err))
+				return
+			}
+		}
+
 		v.ValidateConfig(ctx, request, response)
 	}
 }
@@ -304,13 +792,104 @@ func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.V
 func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	if v, ok := w.inner.(resource.ResourceWithUpgradeState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		upgraders := v.UpgradeState(ctx)
+
+		if !w.regional {
+			return upgraders
+		}
+
+		// State upgraders produce state for the inner resource's schema.
+		// The Region is set on the next refresh.
+		for version, upgrader := range upgraders {
+			f := upgrader.StateUpgrader
+			upgrader.StateUpgrader = func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+				innerResponse := *response
+				var err error
+
+				if innerResponse.State, err = w.innerState(ctx, response.State); err != nil {
+					response.Diagnostics.Append(regionError(err))
+					return
+				}
+
+				f(ctx, request, &innerResponse)
+
+				*response = innerResponse
+				if response.State, err = w.outerState(ctx, innerResponse.State, tftypes.NewValue(tftypes.String, nil)); err != nil {
+					response.Diagnostics.Append(regionError(err))
+				}
+			}
+			upgraders[version] = upgrader
+		}
 
-		return v.UpgradeState(ctx)
+		return upgraders
 	}
 
 	return nil
 }
 
+// regionalMeta returns the provider's instance data for the Region in the specified plan or state,
+// configuring the inner resource for that Region.
+func (w *wrappedResource) regionalMeta(ctx context.Context, getter interface {
+	GetAttribute(context.Context, path.Path, interface{}) diag.Diagnostics
+}, raw tftypes.Value) (*conns.AWSClient, diag.Diagnostics) {
+	region, diags := regionFrom(ctx, getter, raw)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	meta, diags := regionalMeta(ctx, w.meta, region)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return meta, w.configureRegional(ctx, meta)
+}
+
+func (w *wrappedResource) innerConfig(ctx context.Context, config tfsdk.Config) (tfsdk.Config, error) {
+	raw, err := withoutRegion(ctx, config.Raw, w.innerSchema)
+	if err != nil {
+		return config, err
+	}
+
+	return tfsdk.Config{Raw: raw, Schema: w.innerSchema}, nil
+}
+
+func (w *wrappedResource) innerPlan(ctx context.Context, plan tfsdk.Plan) (tfsdk.Plan, error) {
+	raw, err := withoutRegion(ctx, plan.Raw, w.innerSchema)
+	if err != nil {
+		return plan, err
+	}
+
+	return tfsdk.Plan{Raw: raw, Schema: w.innerSchema}, nil
+}
+
+func (w *wrappedResource) innerState(ctx context.Context, state tfsdk.State) (tfsdk.State, error) {
+	raw, err := withoutRegion(ctx, state.Raw, w.innerSchema)
+	if err != nil {
+		return state, err
+	}
+
+	return tfsdk.State{Raw: raw, Schema: w.innerSchema}, nil
+}
+
+func (w *wrappedResource) outerPlan(ctx context.Context, plan tfsdk.Plan, region tftypes.Value) (tfsdk.Plan, error) {
+	raw, err := withRegion(ctx, plan.Raw, w.schema, region)
+	if err != nil {
+		return plan, err
+	}
+
+	return tfsdk.Plan{Raw: raw, Schema: w.schema}, nil
+}
+
+func (w *wrappedResource) outerState(ctx context.Context, state tfsdk.State, region tftypes.Value) (tfsdk.State, error) {
+	raw, err := withRegion(ctx, state.Raw, w.schema, region)
+	if err != nil {
+		return state, err
+	}
+
+	return tfsdk.State{Raw: raw, Schema: w.schema}, nil
+}
+
 // tagsResourceInterceptor implements transparent tagging for resources.
 type tagsResourceInterceptor struct {
 	tags *types.ServicePackageResourceTags
@@ -356,6 +935,19 @@ func (r tagsResourceInterceptor) create(ctx context.Context, request resource.Cr
 		if diags.HasError() {
 			return ctx, diags
 		}
+
+		if identifierAttribute := r.tags.IdentifierAttribute; identifierAttribute != "" {
+			var identifier fwtypes.String
+
+			diags.Append(response.State.GetAttribute(ctx, path.Root(identifierAttribute), &identifier)...)
+
+			if diags.HasError() {
+				return ctx, diags
+			}
+
+			// Tags just written may not yet be returned by the eventually consistent Resource Groups Tagging API.
+			meta.InvalidateCachedResourceTags(ctx, identifier.ValueString())
+		}
 	}
 
 	return ctx, diags
@@ -415,7 +1007,10 @@ func (r tagsResourceInterceptor) read(ctx context.Context, request resource.Read
 					// If the service package has a generic resource list tags methods, call it.
 					var err error
 
-					if v, ok := sp.(interface {
+					// Tags may be read in a batch with the Resource Groups Tagging API.
+					if tags, ok := meta.CachedResourceTags(ctx, identifier); ok {
+						tagsInContext.TagsOut = types.Some(tftags.New(ctx, tags))
+					} else if v, ok := sp.(interface {
 						ListTags(context.Context, any, string) error
 					}); ok {
 						err = v.ListTags(ctx, meta, identifier) // Sets tags in Context
@@ -539,6 +1134,9 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 				// Some old resources may not have the required attribute set after Read:
 				// https://github.com/hashicorp/terraform-provider-aws/issues/31180
 				if identifier != "" {
+					// Tags just written may not yet be returned by the eventually consistent Resource Groups Tagging API.
+					meta.InvalidateCachedResourceTags(ctx, identifier)
+
 					// If the service package has a generic resource update tags methods, call it.
 					var err error
 
@@ -574,3 +1172,65 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+// tracingInterceptor records the resource on whose behalf AWS API calls are made.
+type tracingInterceptor struct {
+	typeName string
+}
+
+func (r tracingInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		ctx = tracing.NewContext(ctx, r.typeName, "create", "")
+	}
+
+	return ctx, diags
+}
+
+func (r tracingInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		ctx = tracing.NewContext(ctx, r.typeName, "read", stateID(ctx, request.State))
+	}
+
+	return ctx, diags
+}
+
+func (r tracingInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		ctx = tracing.NewContext(ctx, r.typeName, "update", stateID(ctx, request.State))
+	}
+
+	return ctx, diags
+}
+
+func (r tracingInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		ctx = tracing.NewContext(ctx, r.typeName, "delete", stateID(ctx, request.State))
+	}
+
+	return ctx, diags
+}
+
+// tracingDataSourceInterceptor records the data source on whose behalf AWS API calls are made.
+type tracingDataSourceInterceptor struct {
+	typeName string
+}
+
+func (r tracingDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		ctx = tracing.NewDataSourceContext(ctx, r.typeName)
+	}
+
+	return ctx, diags
+}
+
+// stateID returns the value of any `id` attribute in state.
+func stateID(ctx context.Context, state tfsdk.State) string {
+	var id string
+
+	// Not all resources have an `id` attribute.
+	if diags := state.GetAttribute(ctx, path.Root(names.AttrID), &id); diags.HasError() {
+		return ""
+	}
+
+	return id
+}
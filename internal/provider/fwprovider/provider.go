@@ -8,8 +8,13 @@ import (
 	"fmt"
 
 	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,7 +23,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -30,6 +37,11 @@ func New(primary interface{ Meta() interface{} }) provider.Provider {
 	}
 }
 
+var (
+	_ provider.ProviderWithEphemeralResources = (*fwprovider)(nil)
+	_ provider.ProviderWithFunctions          = (*fwprovider)(nil)
+)
+
 type fwprovider struct {
 	Primary interface{ Meta() interface{} }
 }
@@ -51,6 +63,14 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"api_trace_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "File to which a JSON Lines record of every AWS API call is appended. Can also be configured using the `TF_AWS_API_TRACE_FILE` environment variable.",
+			},
+			"batch_tag_reads": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to read the tags of resources refreshed at the same time in batches of Resource Groups Tagging API calls, instead of reading each resource's tags separately.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -144,9 +164,6 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"duration": schema.StringAttribute{
@@ -229,6 +246,25 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"credential_process": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"command": schema.StringAttribute{
+							Required:    true,
+							Description: "The command to run, in the format of the `credential_process` shared config file setting.",
+						},
+						"timeout": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "The maximum time the command can run. Valid time units are ns, us (or µs), ms, s, h, or m. Defaults to 1 minute.",
+						},
+					},
+				},
+				Description: "Configuration block for sourcing credentials from an external process.",
+			},
 			"default_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -236,6 +272,13 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"precedence": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(tftags.DefaultTagsPrecedences()...),
+							},
+							Description: "Whether `resource` tags override default tags with the same key, or `provider` default tags cannot be overridden. Defaults to `resource`.",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -245,6 +288,27 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				},
 			},
 			"endpoints": endpointsBlock(),
+			"http_fixtures": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to serve AWS API calls from, or record them to, a directory of HTTP fixtures.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"directory": schema.StringAttribute{
+							Optional:    true,
+							Description: "Directory containing the HTTP fixtures. Can also be configured using the `TF_AWS_HTTP_FIXTURES_DIRECTORY` environment variable.",
+						},
+						"mode": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(vcr.Modes()...),
+							},
+							Description: "Whether to `replay` API calls from the fixtures or `record` them against AWS. Defaults to `replay`. Can also be configured using the `TF_AWS_HTTP_FIXTURES_MODE` environment variable.",
+						},
+					},
+				},
+			},
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -265,6 +329,112 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"rate_limit": schema.ListNestedBlock{
+				Description: "Configuration blocks with client-side limits on the rate and concurrency of API calls to individual AWS services.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"burst": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+							Description: "The maximum number of API calls that can be made at once before `requests_per_second` applies. Defaults to `1`.",
+						},
+						"max_concurrent_requests": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+							Description: "The maximum number of API calls in flight at once.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional: true,
+							Validators: []validator.Float64{
+								float64validator.AtLeast(0),
+							},
+							Description: "The average number of API calls per second.",
+						},
+						"service": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(names.Aliases()...),
+							},
+							Description: "The service, using the same names as the `endpoints` configuration block, e.g. `route53`.",
+						},
+					},
+				},
+			},
+			"sso": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"account_id": schema.StringAttribute{
+							Required:    true,
+							Description: "The ID of the AWS account to retrieve role credentials for.",
+						},
+						"cached_token_path": schema.StringAttribute{
+							Optional:    true,
+							Description: "The path to the cached access token. Defaults to the AWS CLI cache file for the start URL.",
+						},
+						"endpoint": schema.StringAttribute{
+							Optional:    true,
+							Description: "Custom endpoint for the AWS IAM Identity Center portal API.",
+						},
+						"region": schema.StringAttribute{
+							Required:    true,
+							Description: "The AWS Region of the IAM Identity Center instance.",
+						},
+						"role_name": schema.StringAttribute{
+							Required:    true,
+							Description: "The name of the permission set role to retrieve credentials for.",
+						},
+						"start_url": schema.StringAttribute{
+							Required:    true,
+							Description: "The AWS access portal URL.",
+						},
+					},
+				},
+				Description: "Configuration block for sourcing credentials from AWS IAM Identity Center (successor to AWS Single Sign-On).",
+			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with rules that the tags of all resources must comply with, validated when planning.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"enforce_key_case": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Whether tag keys that match a required key or a key with allowed values, ignoring case, must match it exactly.",
+						},
+						"required_keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Keys of the tags that all resources must have.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"allowed_values": schema.SetNestedBlock{
+							Description: "Values allowed for a tag key.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key": schema.StringAttribute{
+										Required:    true,
+										Description: "Tag key.",
+									},
+									"values": schema.SetAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+										Description: "Values allowed for the tag key.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -276,6 +446,7 @@ func (p *fwprovider) Configure(ctx context.Context, request provider.ConfigureRe
 	// Provider's parsed configuration (its instance state) is available through the primary provider's Meta() method.
 	v := p.Primary.Meta()
 	response.DataSourceData = v
+	response.EphemeralResourceData = v
 	response.ResourceData = v
 }
 
@@ -290,6 +461,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 	for n, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
 		servicePackageName := sp.ServicePackageName()
+		global := conns.IsGlobalServicePackage(sp)
 
 		for _, v := range sp.FrameworkDataSources(ctx) {
 			v := v
@@ -317,14 +489,13 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 				return ctx
 			}
-			interceptors := dataSourceInterceptors{}
+			interceptors := dataSourceInterceptors{tracingDataSourceInterceptor{typeName: typeName}}
+			schemaResponse := datasource.SchemaResponse{}
+			inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
 
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := datasource.SchemaResponse{}
-				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if !v.IsComputed() {
 						errs = multierror.Append(errs, fmt.Errorf("`%s` attribute must be Computed: %s", names.AttrTags, typeName))
@@ -339,7 +510,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			}
 
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(bootstrapContext, inner, interceptors)
+				return newWrappedDataSource(bootstrapContext, v.Factory, inner, interceptors, schemaResponse.Schema, global)
 			})
 		}
 	}
@@ -364,6 +535,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
 		servicePackageName := sp.ServicePackageName()
+		global := conns.IsGlobalServicePackage(sp)
 
 		for _, v := range sp.FrameworkResources(ctx) {
 			v := v
@@ -387,14 +559,13 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 				return ctx
 			}
-			interceptors := resourceInterceptors{}
+			interceptors := resourceInterceptors{tracingInterceptor{typeName: typeName}}
+			schemaResponse := resource.SchemaResponse{}
+			inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := resource.SchemaResponse{}
-				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if v.IsComputed() {
 						errs = multierror.Append(errs, fmt.Errorf("`%s` attribute cannot be Computed: %s", names.AttrTags, typeName))
@@ -418,7 +589,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			}
 
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, v.Factory, inner, interceptors, schemaResponse.Schema, global)
 			})
 		}
 	}
@@ -432,6 +603,66 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	return resources
 }
 
+// EphemeralResources returns a slice of functions to instantiate each EphemeralResource
+// implementation.
+//
+// The ephemeral resource type name is determined by the EphemeralResource implementing
+// the Metadata method. All ephemeral resources must have unique names.
+func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	var ephemeralResources []func() ephemeral.EphemeralResource
+
+	for n, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
+		v, ok := sp.(conns.ServicePackageWithEphemeralResources)
+
+		if !ok {
+			continue
+		}
+
+		servicePackageName := sp.ServicePackageName()
+
+		for _, v := range v.EphemeralResources(ctx) {
+			v := v
+			inner, err := v.Factory(ctx)
+
+			if err != nil {
+				tflog.Warn(ctx, "creating ephemeral resource", map[string]interface{}{
+					"service_package_name": n,
+					"error":                err.Error(),
+				})
+
+				continue
+			}
+
+			// bootstrapContext is run on all wrapped methods before any interceptors.
+			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+				return conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name)
+			}
+
+			ephemeralResources = append(ephemeralResources, func() ephemeral.EphemeralResource {
+				return newWrappedEphemeralResource(bootstrapContext, inner)
+			})
+		}
+	}
+
+	return ephemeralResources
+}
+
+// Functions returns a slice of functions to instantiate each Function
+// implementation.
+//
+// The function type name is determined by the Function implementing
+// the Metadata method. All functions must have unique names.
+// Functions do not depend on provider configuration, so they are callable
+// without any AWS credentials.
+func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		tffunction.NewARNBuildFunction,
+		tffunction.NewARNParseFunction,
+		tffunction.NewIAMPolicyEqualFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+	}
+}
+
 func endpointsBlock() schema.SetNestedBlock {
 	endpointsAttributes := make(map[string]schema.Attribute)
 
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	regionAttributeDescription = "Region where this resource will be managed. Defaults to the Region set in the provider configuration."
+)
+
+// A resource's or data source's implementation knows nothing about the `region` attribute
+// added to its schema, so plan, state and configuration values are passed to it without that attribute
+// and the attribute is added back to any values returned.
+
+type schemaWithType interface {
+	Type() attr.Type
+}
+
+// withoutRegion returns the specified object value without the `region` attribute.
+func withoutRegion(ctx context.Context, value tftypes.Value, schema schemaWithType) (tftypes.Value, error) {
+	typ := schema.Type().TerraformType(ctx)
+
+	if !value.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	if value.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	var m map[string]tftypes.Value
+	if err := value.As(&m); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	delete(m, names.AttrRegion)
+
+	return tftypes.NewValue(typ, m), nil
+}
+
+// withRegion returns the specified object value with the `region` attribute set to the specified value.
+// Null and unknown object values are returned as-is.
+func withRegion(ctx context.Context, value tftypes.Value, schema schemaWithType, region tftypes.Value) (tftypes.Value, error) {
+	typ := schema.Type().TerraformType(ctx)
+
+	if !value.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	if value.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	var m map[string]tftypes.Value
+	if err := value.As(&m); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	m[names.AttrRegion] = region
+
+	return tftypes.NewValue(typ, m), nil
+}
+
+// regionFrom returns the value of the `region` attribute in the specified plan, state or configuration.
+func regionFrom(ctx context.Context, getter interface {
+	GetAttribute(context.Context, path.Path, interface{}) diag.Diagnostics
+}, raw tftypes.Value) (types.String, diag.Diagnostics) {
+	var region types.String
+
+	if raw.IsNull() || !raw.IsKnown() {
+		return types.StringNull(), nil
+	}
+
+	diags := getter.GetAttribute(ctx, path.Root(names.AttrRegion), &region)
+
+	return region, diags
+}
+
+// regionalMeta returns the provider's instance data for the specified Region,
+// recording any Region override in Context so that API clients are created for that Region.
+func regionalMeta(ctx context.Context, meta *conns.AWSClient, region types.String) (*conns.AWSClient, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if meta == nil || region.IsNull() || region.IsUnknown() {
+		return meta, diags
+	}
+
+	v, err := meta.RegionalClient(region.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root(names.AttrRegion), "Invalid Region", err.Error())
+
+		return nil, diags
+	}
+
+	if inContext, ok := conns.FromContext(ctx); ok {
+		inContext.Region = v.Region
+	}
+
+	return v, diags
+}
+
+func regionValue(meta *conns.AWSClient) tftypes.Value {
+	if meta == nil {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+
+	return tftypes.NewValue(tftypes.String, meta.Region)
+}
+
+func regionError(err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic("Region", fmt.Sprintf("translating `%s` attribute: %s", names.AttrRegion, err))
+}
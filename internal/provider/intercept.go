@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -77,6 +78,21 @@ const (
 	AllOps = Create | Read | Update | Delete // Interceptor is invoked for all calls
 )
 
+func (w why) String() string {
+	switch w {
+	case Create:
+		return "create"
+	case Read:
+		return "read"
+	case Update:
+		return "update"
+	case Delete:
+		return "delete"
+	default:
+		return ""
+	}
+}
+
 type interceptorItems []interceptorItem
 
 // why returns a slice of interceptors that run for the specified CRUD operation.
@@ -87,10 +103,16 @@ func (s interceptorItems) why(why why) interceptorItems {
 }
 
 // interceptedHandler returns a handler that invokes the specified CRUD handler, running any interceptors.
-func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](bootstrapContext contextFunc, interceptors interceptorItems, f F, why why) F {
+func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](bootstrapContext contextFunc, regional bool, interceptors interceptorItems, f F, why why) F {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		var diags diag.Diagnostics
 		ctx = bootstrapContext(ctx, meta)
+		if regional {
+			var err error
+			if meta, err = regionalMeta(ctx, d, meta); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
 		// Before interceptors are run first to last.
 		forward := interceptors.why(why)
 
@@ -140,10 +162,12 @@ type wrappedDataSource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	interceptors     interceptorItems
+	// regional is true if a `region` attribute has been added to the data source's schema.
+	regional bool
 }
 
 func (ds *wrappedDataSource) Read(f schema.ReadContextFunc) schema.ReadContextFunc {
-	return interceptedHandler(ds.bootstrapContext, ds.interceptors, f, Read)
+	return interceptedHandler(ds.bootstrapContext, ds.regional, ds.interceptors, f, Read)
 }
 
 // wrappedResource represents an interceptor dispatcher for a Plugin SDK v2 resource.
@@ -151,28 +175,45 @@ type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	interceptors     interceptorItems
+	// regional is true if a `region` attribute has been added to the resource's schema.
+	regional bool
 }
 
 func (r *wrappedResource) Create(f schema.CreateContextFunc) schema.CreateContextFunc {
-	return interceptedHandler(r.bootstrapContext, r.interceptors, f, Create)
+	return interceptedHandler(r.bootstrapContext, r.regional, r.interceptors, f, Create)
 }
 
 func (r *wrappedResource) Read(f schema.ReadContextFunc) schema.ReadContextFunc {
-	return interceptedHandler(r.bootstrapContext, r.interceptors, f, Read)
+	return interceptedHandler(r.bootstrapContext, r.regional, r.interceptors, f, Read)
 }
 
 func (r *wrappedResource) Update(f schema.UpdateContextFunc) schema.UpdateContextFunc {
-	return interceptedHandler(r.bootstrapContext, r.interceptors, f, Update)
+	return interceptedHandler(r.bootstrapContext, r.regional, r.interceptors, f, Update)
 }
 
 func (r *wrappedResource) Delete(f schema.DeleteContextFunc) schema.DeleteContextFunc {
-	return interceptedHandler(r.bootstrapContext, r.interceptors, f, Delete)
+	return interceptedHandler(r.bootstrapContext, r.regional, r.interceptors, f, Delete)
 }
 
 func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 		ctx = r.bootstrapContext(ctx, meta)
 
+		if r.regional {
+			// Import IDs can be suffixed with the resource's Region, e.g. `vpc-12345678@us-west-2`.
+			if id, region, ok := conns.SplitRegionalImportID(d.Id()); ok {
+				d.SetId(id)
+				if err := d.Set(names.AttrRegion, region); err != nil {
+					return nil, err
+				}
+			}
+
+			var err error
+			if meta, err = regionalMeta(ctx, d, meta); err != nil {
+				return nil, err
+			}
+		}
+
 		return f(ctx, d, meta)
 	}
 }
@@ -314,10 +355,22 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 					// Some old resources may not have the required attribute set after Read:
 					// https://github.com/hashicorp/terraform-provider-aws/issues/31180
 					if identifier != "" {
+						// On refresh, tags may be read in a batch with the Resource Groups Tagging API.
+						var cachedTags map[string]string
+						var cached bool
+						if why == Read {
+							cachedTags, cached = meta.(*conns.AWSClient).CachedResourceTags(ctx, identifier)
+						} else {
+							// Tags just written may not yet be returned by the eventually consistent Resource Groups Tagging API.
+							meta.(*conns.AWSClient).InvalidateCachedResourceTags(ctx, identifier)
+						}
+
 						// If the service package has a generic resource list tags methods, call it.
 						var err error
 
-						if v, ok := sp.(interface {
+						if cached {
+							tagsInContext.TagsOut = types.Some(tftags.New(ctx, cachedTags))
+						} else if v, ok := sp.(interface {
 							ListTags(context.Context, any, string) error
 						}); ok {
 							err = v.ListTags(ctx, meta, identifier) // Sets tags in Context
@@ -442,3 +495,16 @@ func (r tagsDataSourceInterceptor) run(ctx context.Context, d schemaResourceData
 
 	return ctx, diags
 }
+
+// tracingInterceptor records the resource or data source on whose behalf AWS API calls are made.
+type tracingInterceptor struct {
+	typeName string
+}
+
+func (r tracingInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if v, ok := conns.FromContext(ctx); ok && v.IsDataSource {
+		return tracing.NewDataSourceContext(ctx, r.typeName), diags
+	}
+
+	return tracing.NewContext(ctx, r.typeName, why.String(), d.Id()), diags
+}
@@ -20,9 +20,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -46,8 +49,42 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"forbidden_account_ids"},
 			},
+			"api_trace_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "File to which a JSON Lines record of every AWS API call is appended. " +
+					"Can also be configured using the `TF_AWS_API_TRACE_FILE` environment variable.",
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"batch_tag_reads": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Whether to read the tags of resources refreshed at the same time in batches of Resource Groups Tagging API calls, " +
+					"instead of reading each resource's tags separately.",
+			},
+			"credential_process": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"access_key", "assume_role_with_web_identity", "profile", "secret_key", "sso", "token"},
+				Description:   "Configuration block for sourcing credentials from an external process.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"command": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The command to run, in the format of the `credential_process` shared config file setting.",
+						},
+						"timeout": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The maximum time the command can run. Valid time units are ns, us (or µs), ms, s, h, or m. Defaults to 1 minute.",
+							ValidateFunc: verify.ValidDuration,
+						},
+					},
+				},
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -62,6 +99,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"precedence": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(tftags.DefaultTagsPrecedences(), false),
+							Description: "Whether `resource` tags override default tags with the same key, or `provider` default tags cannot be overridden. " +
+								"Defaults to `resource`.",
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -90,6 +134,29 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"allowed_account_ids"},
 			},
+			"http_fixtures": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to serve AWS API calls from, or record them to, a directory of HTTP fixtures.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"directory": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "Directory containing the HTTP fixtures. " +
+								"Can also be configured using the `TF_AWS_HTTP_FIXTURES_DIRECTORY` environment variable.",
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(vcr.Modes(), false),
+							Description: "Whether to `replay` API calls from the fixtures or `record` them against AWS. Defaults to `replay`. " +
+								"Can also be configured using the `TF_AWS_HTTP_FIXTURES_MODE` environment variable.",
+						},
+					},
+				},
+			},
 			"http_proxy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -137,6 +204,39 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"rate_limit": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks with client-side limits on the rate and concurrency of API calls to individual AWS services.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"burst": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum number of API calls that can be made at once before `requests_per_second` applies. Defaults to `1`.",
+						},
+						"max_concurrent_requests": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum number of API calls in flight at once.",
+						},
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0),
+							Description:  "The average number of API calls per second.",
+						},
+						"service": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(names.Aliases(), false),
+							Description:  "The service, using the same names as the `endpoints` configuration block, e.g. `route53`.",
+						},
+					},
+				},
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -200,12 +300,96 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Skip requesting the account ID. " +
 					"Used for AWS API implementations that do not have IAM/STS API and/or metadata API.",
 			},
+			"sso": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"access_key", "assume_role_with_web_identity", "credential_process", "profile", "secret_key", "token"},
+				Description:   "Configuration block for sourcing credentials from AWS IAM Identity Center (successor to AWS Single Sign-On).",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The ID of the AWS account to retrieve role credentials for.",
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"cached_token_path": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The path to the cached access token. Defaults to the AWS CLI cache file for the start URL.",
+						},
+						"endpoint": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Custom endpoint for the AWS IAM Identity Center portal API.",
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The AWS Region of the IAM Identity Center instance.",
+						},
+						"role_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the permission set role to retrieve credentials for.",
+						},
+						"start_url": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The AWS access portal URL.",
+						},
+					},
+				},
+			},
 			"sts_region": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with rules that the tags of all resources must comply with, validated when planning.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_values": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Values allowed for a tag key.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Tag key.",
+									},
+									"values": {
+										Type:        schema.TypeSet,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Values allowed for the tag key.",
+									},
+								},
+							},
+						},
+						"enforce_key_case": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether tag keys that match a required key or a key with allowed values, ignoring case, must match it exactly.",
+						},
+						"required_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Keys of the tags that all resources must have.",
+						},
+					},
+				},
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -241,6 +425,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 	for _, sp := range servicePackages(ctx) {
 		servicePackageName := sp.ServicePackageName()
 		servicePackageMap[servicePackageName] = sp
+		global := conns.IsGlobalServicePackage(sp)
 
 		for _, v := range sp.SDKDataSources(ctx) {
 			v := v
@@ -268,7 +453,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptorItems{
+				{
+					when:        Before,
+					why:         AllOps,
+					interceptor: tracingInterceptor{typeName: typeName},
+				},
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -294,9 +485,21 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			// Every data source in a Regional service without its own `region` attribute can read from any Region.
+			regional := !global && injectRegionSchema(r, dataSourceRegionSchema)
+
+			if regional {
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Read,
+					interceptor: regionInterceptor{},
+				})
+			}
+
 			ds := &wrappedDataSource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
+				regional:         regional,
 			}
 
 			if v := r.ReadWithoutTimeout; v != nil {
@@ -344,7 +547,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptorItems{
+				{
+					when:        Before,
+					why:         AllOps,
+					interceptor: tracingInterceptor{typeName: typeName},
+				},
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -381,9 +590,23 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			// Every resource in a Regional service without its own `region` attribute can be managed in any Region.
+			regional := !global && injectRegionSchema(r, resourceRegionSchema)
+
+			if regional {
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Create | Read | Update,
+					interceptor: regionInterceptor{},
+				})
+
+				r.CustomizeDiff = withRegionCustomizeDiff(r.CustomizeDiff)
+			}
+
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
+				regional:         regional,
 			}
 
 			if v := r.CreateWithoutTimeout; v != nil {
@@ -436,6 +659,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 }
 
 // configure ensures that the provider is fully configured.
+// ServicePackages returns the provider's service packages.
+func ServicePackages(ctx context.Context) []conns.ServicePackage {
+	return servicePackages(ctx)
+}
+
 func configure(ctx context.Context, provider *schema.Provider, d *schema.ResourceData) (*conns.AWSClient, diag.Diagnostics) {
 	terraformVersion := provider.TerraformVersion
 	if terraformVersion == "" {
@@ -479,14 +707,36 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		tflog.Info(ctx, "assume_role configuration set", map[string]any{
-			"tf_aws.assume_role.role_arn":        config.AssumeRole.RoleARN,
-			"tf_aws.assume_role.session_name":    config.AssumeRole.SessionName,
-			"tf_aws.assume_role.external_id":     config.AssumeRole.ExternalID,
-			"tf_aws.assume_role.source_identity": config.AssumeRole.SourceIdentity,
-		})
+	config.APITraceFile = os.Getenv(envvar.APITraceFile)
+	if v, ok := d.GetOk("api_trace_file"); ok {
+		config.APITraceFile = v.(string)
+	}
+
+	if v, ok := d.GetOk("assume_role"); ok {
+		// Roles are assumed in order, each using the credentials of the one before.
+		for i, tfMapRaw := range v.([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			assumeRole := expandAssumeRole(ctx, tfMap)
+
+			// An empty role ARN, e.g. from an unset variable, disables assuming that role.
+			if assumeRole.RoleARN == "" {
+				continue
+			}
+
+			config.AssumeRole = append(config.AssumeRole, *assumeRole)
+			tflog.Info(ctx, "assume_role configuration set", map[string]any{
+				"tf_aws.assume_role.index":           i,
+				"tf_aws.assume_role.role_arn":        assumeRole.RoleARN,
+				"tf_aws.assume_role.session_name":    assumeRole.SessionName,
+				"tf_aws.assume_role.external_id":     assumeRole.ExternalID,
+				"tf_aws.assume_role.source_identity": assumeRole.SourceIdentity,
+			})
+		}
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -497,6 +747,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		})
 	}
 
+	if v, ok := d.GetOk("batch_tag_reads"); ok {
+		config.BatchTagReads = v.(bool)
+	}
+
+	if v, ok := d.GetOk("credential_process"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.CredentialProcess = expandCredentialProcess(ctx, v.([]interface{})[0].(map[string]interface{}))
+		tflog.Info(ctx, "credential_process configuration set")
+	}
+
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -515,14 +774,44 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	config.HTTPFixturesDirectory, config.HTTPFixturesMode = os.Getenv(envvar.HTTPFixturesDirectory), os.Getenv(envvar.HTTPFixturesMode)
+	if v, ok := d.GetOk("http_fixtures"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		if v, ok := tfMap["directory"].(string); ok && v != "" {
+			config.HTTPFixturesDirectory = v
+		}
+
+		if v, ok := tfMap["mode"].(string); ok && v != "" {
+			config.HTTPFixturesMode = v
+		}
+	}
+	if config.HTTPFixturesDirectory != "" && config.HTTPFixturesMode == "" {
+		config.HTTPFixturesMode = vcr.ModeReplay
+	}
+
 	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.TagPolicyConfig = expandTagPolicy(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("rate_limit"); ok && len(v.([]interface{})) > 0 {
+		rateLimits, err := expandRateLimits(ctx, v.([]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.RateLimits = rateLimits
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -539,6 +828,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		}
 	}
 
+	if v, ok := d.GetOk("sso"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.SSO = expandSSO(ctx, v.([]interface{})[0].(map[string]interface{}))
+		tflog.Info(ctx, "sso configuration set", map[string]any{
+			"tf_aws.sso.account_id": config.SSO.AccountID,
+			"tf_aws.sso.role_name":  config.SSO.RoleName,
+			"tf_aws.sso.start_url":  config.SSO.StartURL,
+		})
+	}
+
 	var meta *conns.AWSClient
 	if v, ok := provider.Meta().(*conns.AWSClient); ok {
 		meta = v
@@ -558,7 +856,6 @@ func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
-		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
@@ -789,6 +1086,59 @@ func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]interfa
 	return &assumeRole
 }
 
+func expandCredentialProcess(_ context.Context, tfMap map[string]interface{}) *conns.CredentialProcess {
+	if tfMap == nil {
+		return nil
+	}
+
+	credentialProcess := conns.CredentialProcess{}
+
+	if v, ok := tfMap["command"].(string); ok && v != "" {
+		credentialProcess.Command = v
+	}
+
+	if v, ok := tfMap["timeout"].(string); ok && v != "" {
+		timeout, _ := time.ParseDuration(v)
+		credentialProcess.Timeout = timeout
+	}
+
+	return &credentialProcess
+}
+
+func expandSSO(_ context.Context, tfMap map[string]interface{}) *conns.SSO {
+	if tfMap == nil {
+		return nil
+	}
+
+	sso := conns.SSO{}
+
+	if v, ok := tfMap["account_id"].(string); ok && v != "" {
+		sso.AccountID = v
+	}
+
+	if v, ok := tfMap["cached_token_path"].(string); ok && v != "" {
+		sso.CachedTokenPath = v
+	}
+
+	if v, ok := tfMap["endpoint"].(string); ok && v != "" {
+		sso.Endpoint = v
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		sso.Region = v
+	}
+
+	if v, ok := tfMap["role_name"].(string); ok && v != "" {
+		sso.RoleName = v
+	}
+
+	if v, ok := tfMap["start_url"].(string); ok && v != "" {
+		sso.StartURL = v
+	}
+
+	return &sso
+}
+
 func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftags.DefaultConfig {
 	if tfMap == nil {
 		return nil
@@ -796,6 +1146,10 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 
 	defaultConfig := &tftags.DefaultConfig{}
 
+	if v, ok := tfMap["precedence"].(string); ok && v != "" {
+		defaultConfig.Precedence = v
+	}
+
 	if v, ok := tfMap["tags"].(map[string]interface{}); ok {
 		defaultConfig.Tags = tftags.New(ctx, v)
 	}
@@ -821,6 +1175,65 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandTagPolicy(tfMap map[string]interface{}) *tftags.PolicyConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	policyConfig := &tftags.PolicyConfig{}
+
+	if v, ok := tfMap["allowed_values"].(*schema.Set); ok && v.Len() > 0 {
+		policyConfig.AllowedValues = make(map[string][]string)
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			key := tfMap["key"].(string)
+			policyConfig.AllowedValues[key] = append(policyConfig.AllowedValues[key], flex.ExpandStringValueSet(tfMap["values"].(*schema.Set))...)
+		}
+	}
+
+	if v, ok := tfMap["enforce_key_case"].(bool); ok {
+		policyConfig.EnforceKeyCase = v
+	}
+
+	if v, ok := tfMap["required_keys"].(*schema.Set); ok && v.Len() > 0 {
+		policyConfig.RequiredKeys = flex.ExpandStringValueSet(v)
+	}
+
+	return policyConfig
+}
+
+func expandRateLimits(_ context.Context, tfList []interface{}) (map[string]*ratelimit.Limiter, error) {
+	rateLimits := make(map[string]*ratelimit.Limiter)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		alias := tfMap["service"].(string)
+		pkg, err := names.ProviderPackageForAlias(alias)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign rate limit (%s): %w", alias, err)
+		}
+
+		if _, ok := rateLimits[pkg]; ok {
+			return nil, fmt.Errorf("duplicate rate limit (%s)", alias)
+		}
+
+		rateLimits[pkg] = ratelimit.New(tfMap["requests_per_second"].(float64), tfMap["burst"].(int), tfMap["max_concurrent_requests"].(int))
+	}
+
+	return rateLimits, nil
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, error) {
 	if len(tfList) == 0 {
 		return nil, nil
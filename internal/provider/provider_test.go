@@ -6,9 +6,13 @@ package provider
 import (
 	"context"
 	"os"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -205,3 +209,169 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestExpandCredentialProcess(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Input    map[string]interface{}
+		Expected *conns.CredentialProcess
+	}{
+		{
+			Name: "nil",
+		},
+		{
+			Name:     "command",
+			Input:    map[string]interface{}{"command": "custom-process --username jdoe", "timeout": ""},
+			Expected: &conns.CredentialProcess{Command: "custom-process --username jdoe"},
+		},
+		{
+			Name:     "timeout",
+			Input:    map[string]interface{}{"command": "custom-process --username jdoe", "timeout": "30s"},
+			Expected: &conns.CredentialProcess{Command: "custom-process --username jdoe", Timeout: 30 * time.Second},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := expandCredentialProcess(context.Background(), testCase.Input); !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %+v, expected %+v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestExpandSSO(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Input    map[string]interface{}
+		Expected *conns.SSO
+	}{
+		{
+			Name: "nil",
+		},
+		{
+			Name: "required",
+			Input: map[string]interface{}{
+				"account_id":        "123456789012",
+				"cached_token_path": "",
+				"endpoint":          "",
+				"region":            "us-east-1", //lintignore:AWSAT003
+				"role_name":         "Developer",
+				"start_url":         "https://example.awsapps.com/start",
+			},
+			Expected: &conns.SSO{
+				AccountID: "123456789012",
+				Region:    "us-east-1", //lintignore:AWSAT003
+				RoleName:  "Developer",
+				StartURL:  "https://example.awsapps.com/start",
+			},
+		},
+		{
+			Name: "all",
+			Input: map[string]interface{}{
+				"account_id":        "123456789012",
+				"cached_token_path": "/tmp/token.json",
+				"endpoint":          "https://portal.sso.us-east-1.amazonaws.com",
+				"region":            "us-east-1", //lintignore:AWSAT003
+				"role_name":         "Developer",
+				"start_url":         "https://example.awsapps.com/start",
+			},
+			Expected: &conns.SSO{
+				AccountID:       "123456789012",
+				CachedTokenPath: "/tmp/token.json",
+				Endpoint:        "https://portal.sso.us-east-1.amazonaws.com",
+				Region:          "us-east-1", //lintignore:AWSAT003
+				RoleName:        "Developer",
+				StartURL:        "https://example.awsapps.com/start",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := expandSSO(context.Background(), testCase.Input); !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %+v, expected %+v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+// TestProviderExternalCredentialsConflicts verifies that credentials from external sources can't be configured
+// together with other credentials, which they would replace.
+func TestProviderExternalCredentialsConflicts(t *testing.T) {
+	t.Parallel()
+
+	p, err := New(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	credentialProcess := []interface{}{map[string]interface{}{"command": "custom-process"}}
+	sso := []interface{}{map[string]interface{}{
+		"account_id": "123456789012",
+		"region":     "us-east-1", //lintignore:AWSAT003
+		"role_name":  "Developer",
+		"start_url":  "https://example.awsapps.com/start",
+	}}
+
+	testCases := []struct {
+		Name          string
+		Config        map[string]interface{}
+		ExpectedError bool
+	}{
+		{
+			Name:   "credential_process",
+			Config: map[string]interface{}{"credential_process": credentialProcess},
+		},
+		{
+			Name:   "credential_process and assume_role",
+			Config: map[string]interface{}{"credential_process": credentialProcess, "assume_role": []interface{}{map[string]interface{}{"role_arn": "arn:aws:iam::123456789012:role/example"}}}, //lintignore:AWSAT005
+		},
+		{
+			Name:          "credential_process and access_key",
+			Config:        map[string]interface{}{"credential_process": credentialProcess, "access_key": "AKID", "secret_key": "secret"},
+			ExpectedError: true,
+		},
+		{
+			Name:          "credential_process and sso",
+			Config:        map[string]interface{}{"credential_process": credentialProcess, "sso": sso},
+			ExpectedError: true,
+		},
+		{
+			Name:          "sso and profile",
+			Config:        map[string]interface{}{"sso": sso, "profile": "default"},
+			ExpectedError: true,
+		},
+		{
+			Name: "sso and assume_role_with_web_identity",
+			Config: map[string]interface{}{"sso": sso, "assume_role_with_web_identity": []interface{}{map[string]interface{}{
+				"role_arn":                "arn:aws:iam::123456789012:role/example", //lintignore:AWSAT005
+				"web_identity_token_file": "/tmp/token",
+			}}},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := p.Validate(terraform.NewResourceConfigRaw(testCase.Config))
+
+			if got, expected := diags.HasError(), testCase.ExpectedError; got != expected {
+				t.Errorf("got error %t, expected %t: %v", got, expected, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	regionAttributeDescription = "Region where this resource will be managed. Defaults to the Region set in the provider configuration."
+)
+
+var (
+	// Every resource and data source without its own `region` attribute shares these schemas.
+	dataSourceRegionSchema = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: regionAttributeDescription,
+	}
+	resourceRegionSchema = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: regionAttributeDescription,
+	}
+)
+
+// injectRegionSchema adds the specified `region` attribute to a resource's or data source's schema.
+// Returns false if the schema already has its own `region` attribute.
+func injectRegionSchema(r *schema.Resource, regionSchema *schema.Schema) bool {
+	if v, ok := r.SchemaMap()[names.AttrRegion]; ok {
+		// Some resources and data sources share a schema.
+		return v == dataSourceRegionSchema || v == resourceRegionSchema
+	}
+
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			m := f()
+			m[names.AttrRegion] = regionSchema
+
+			return m
+		}
+	} else {
+		if r.Schema == nil {
+			r.Schema = make(map[string]*schema.Schema)
+		}
+		r.Schema[names.AttrRegion] = regionSchema
+	}
+
+	return true
+}
+
+// regionalMeta returns the provider's instance data for the Region configured in the resource or data source,
+// recording any Region override in Context.
+func regionalMeta(ctx context.Context, d schemaResourceData, meta any) (any, error) {
+	v, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return meta, nil
+	}
+
+	region, _ := d.Get(names.AttrRegion).(string)
+	v, err := v.RegionalClient(region)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if inContext, ok := conns.FromContext(ctx); ok {
+		inContext.Region = v.Region
+	}
+
+	return v, nil
+}
+
+// regionInterceptor sets the `region` attribute in state after a successful Create, Read or Update.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		switch why {
+		case Create, Read, Update:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			if v, ok := meta.(*conns.AWSClient); ok {
+				if err := d.Set(names.AttrRegion, v.Region); err != nil {
+					return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+				}
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// regionCustomizeDiff plans a resource's Region as the provider's configured Region when none is configured.
+// A change of Region forces a new resource.
+func regionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if v := d.GetRawConfig(); v.IsNull() || !v.IsKnown() || !v.GetAttr(names.AttrRegion).IsNull() {
+		return nil
+	}
+
+	v, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return nil
+	}
+
+	// Resources in state from before the `region` attribute was added have their Region set on the next refresh.
+	if d.Id() != "" && d.Get(names.AttrRegion).(string) == "" {
+		return nil
+	}
+
+	if d.Get(names.AttrRegion).(string) != v.Region {
+		return d.SetNew(names.AttrRegion, v.Region)
+	}
+
+	return nil
+}
+
+// withRegionCustomizeDiff adds Region planning to a resource's CustomizeDiff.
+func withRegionCustomizeDiff(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	if f == nil {
+		return regionCustomizeDiff
+	}
+
+	return customdiff.Sequence(regionCustomizeDiff, f)
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package ratelimit implements client-side limits on the rate and concurrency of AWS API calls.
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Limiter limits the rate of requests using a token bucket
+// and, optionally, the number of requests in flight at once.
+// A Limiter is safe for concurrent use.
+type Limiter struct {
+	burst     float64
+	rate      float64       // Tokens per second, 0 for unlimited.
+	semaphore chan struct{} // nil for unlimited concurrency.
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+
+	requests  atomic.Int64
+	throttled atomic.Int64
+	waited    atomic.Int64 // Nanoseconds.
+}
+
+// Stats are cumulative counts for all requests made through a Limiter.
+type Stats struct {
+	Requests  int64
+	Throttled int64 // Requests rejected by AWS with a throttling error.
+	Waited    time.Duration
+}
+
+// New returns a Limiter allowing requestsPerSecond requests per second on average with bursts of up to burst requests,
+// and at most maxConcurrency requests in flight at once.
+// A zero requestsPerSecond or maxConcurrency means no limit. A burst of less than 1 is treated as 1.
+func New(requestsPerSecond float64, burst, maxConcurrency int) *Limiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	l := &Limiter{
+		burst:  float64(burst),
+		rate:   requestsPerSecond,
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+
+	if maxConcurrency > 0 {
+		l.semaphore = make(chan struct{}, maxConcurrency)
+	}
+
+	return l
+}
+
+// Wait blocks until a request is allowed or the Context is done.
+// On success the returned function must be called once the request has completed.
+func (l *Limiter) Wait(ctx context.Context) (func(), time.Duration, error) {
+	start := l.now()
+
+	if l.semaphore != nil {
+		select {
+		case l.semaphore <- struct{}{}:
+		case <-ctx.Done():
+			return nil, 0, ctx.Err()
+		}
+	}
+
+	release := func() {
+		if l.semaphore != nil {
+			<-l.semaphore
+		}
+	}
+
+	if delay := l.reserve(); delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			l.cancel()
+			release()
+			return nil, 0, ctx.Err()
+		}
+	}
+
+	waited := l.now().Sub(start)
+	l.requests.Add(1)
+	l.waited.Add(int64(waited))
+
+	return release, waited, nil
+}
+
+// Throttled records that AWS rejected a request with a throttling error.
+func (l *Limiter) Throttled() {
+	l.throttled.Add(1)
+}
+
+// Stats returns the cumulative counts for all requests made through the Limiter.
+func (l *Limiter) Stats() Stats {
+	return Stats{
+		Requests:  l.requests.Load(),
+		Throttled: l.throttled.Load(),
+		Waited:    time.Duration(l.waited.Load()),
+	}
+}
+
+// reserve takes a token from the bucket, returning how long to wait before it can be used.
+func (l *Limiter) reserve() time.Duration {
+	if l.rate <= 0 {
+		return 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns an unused token to the bucket.
+func (l *Limiter) cancel() {
+	if l.rate <= 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens++
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLimiterReserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := New(2, 3, 0)
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		if got := l.reserve(); got != 0 {
+			t.Fatalf("burst request %d: got delay %s, expected none", i, got)
+		}
+	}
+
+	if got, expected := l.reserve(), 500*time.Millisecond; got != expected {
+		t.Errorf("got delay %s, expected %s", got, expected)
+	}
+
+	now = now.Add(10 * time.Second)
+
+	// The bucket refills to no more than the burst size.
+	for i := 0; i < 3; i++ {
+		if got := l.reserve(); got != 0 {
+			t.Fatalf("refilled request %d: got delay %s, expected none", i, got)
+		}
+	}
+
+	if got := l.reserve(); got == 0 {
+		t.Error("expected delay")
+	}
+}
+
+func TestLimiterUnlimitedRate(t *testing.T) {
+	t.Parallel()
+
+	l := New(0, 0, 0)
+
+	for i := 0; i < 100; i++ {
+		if got := l.reserve(); got != 0 {
+			t.Fatalf("request %d: got delay %s, expected none", i, got)
+		}
+	}
+}
+
+func TestLimiterConcurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := New(0, 0, 1)
+
+	release, _, err := l.Wait(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if _, _, err := l.Wait(ctx2); err == nil {
+		t.Fatal("expected error waiting for a second concurrent request")
+	}
+
+	release()
+
+	release, _, err = l.Wait(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error after release: %s", err)
+	}
+	release()
+
+	if got, expected := l.Stats().Requests, int64(2); got != expected {
+		t.Errorf("got %d requests, expected %d", got, expected)
+	}
+}
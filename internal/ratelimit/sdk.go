@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// operationStats records the rate limiting of a single API operation, across all attempts.
+type operationStats struct {
+	release   func()
+	throttled int
+	waited    time.Duration
+}
+
+type operationStatsKey struct{}
+
+func operationStatsFromContext(ctx context.Context) *operationStats {
+	if v, ok := ctx.Value(operationStatsKey{}).(*operationStats); ok {
+		return v
+	}
+
+	return &operationStats{}
+}
+
+// AddSDKv1Handlers adds request handlers that apply the Limiter to each attempt of every AWS SDK for Go v1 API operation.
+func AddSDKv1Handlers(handlers *request.Handlers, service string, l *Limiter) {
+	handlers.Build.PushFrontNamed(request.NamedHandler{
+		Name: "tfratelimit.Stats",
+		Fn: func(r *request.Request) {
+			r.SetContext(context.WithValue(r.Context(), operationStatsKey{}, &operationStats{}))
+		},
+	})
+	// Wait in Send rather than Sign so that presigned requests, which are never sent, don't acquire a slot.
+	handlers.Send.PushFrontNamed(request.NamedHandler{
+		Name: "tfratelimit.Wait",
+		Fn: func(r *request.Request) {
+			if r.Error != nil {
+				return
+			}
+
+			stats, ok := r.Context().Value(operationStatsKey{}).(*operationStats)
+			if !ok {
+				stats = &operationStats{}
+				r.SetContext(context.WithValue(r.Context(), operationStatsKey{}, stats))
+			}
+			stats.done()
+
+			release, waited, err := l.Wait(r.Context())
+			if err != nil {
+				r.Error = err
+				return
+			}
+
+			stats.release = release
+			stats.waited += waited
+		},
+	})
+	// CompleteAttempt handlers run after every attempt, even if Send fails.
+	handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: "tfratelimit.Release",
+		Fn: func(r *request.Request) {
+			operationStatsFromContext(r.Context()).done()
+		},
+	})
+	handlers.AfterRetry.PushFrontNamed(request.NamedHandler{
+		Name: "tfratelimit.Throttled",
+		Fn: func(r *request.Request) {
+			if request.IsErrorThrottle(r.Error) {
+				operationStatsFromContext(r.Context()).throttled++
+				l.Throttled()
+			}
+		},
+	})
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "tfratelimit.Log",
+		Fn: func(r *request.Request) {
+			ctx := r.Context()
+			stats := operationStatsFromContext(ctx)
+			stats.done()
+			logStats(ctx, service, r.Operation.Name, l, stats)
+		},
+	})
+}
+
+// AddSDKv2Middleware returns an API option that applies the Limiter to each attempt of every AWS SDK for Go v2 API operation.
+func AddSDKv2Middleware(service string, l *Limiter) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		if err := stack.Initialize.Add(middleware.InitializeMiddlewareFunc("tfratelimit.Stats", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			stats := &operationStats{}
+			ctx = context.WithValue(ctx, operationStatsKey{}, stats)
+
+			out, metadata, err := next.HandleInitialize(ctx, in)
+
+			logStats(ctx, service, awsmiddleware.GetOperationName(ctx), l, stats)
+
+			return out, metadata, err
+		}), middleware.Before); err != nil {
+			return err
+		}
+
+		// Retries happen in the Finalize step, so wait for each attempt after the retry middleware.
+		return stack.Finalize.Insert(middleware.FinalizeMiddlewareFunc("tfratelimit.Wait", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			release, waited, err := l.Wait(ctx)
+			if err != nil {
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+			}
+			defer release()
+
+			stats := operationStatsFromContext(ctx)
+			stats.waited += waited
+
+			out, metadata, err := next.HandleFinalize(ctx, in)
+
+			if retry_sdkv2.IsErrorThrottles(retry_sdkv2.DefaultThrottles).IsErrorThrottle(err) == aws_sdkv2.TrueTernary {
+				stats.throttled++
+				l.Throttled()
+			}
+
+			return out, metadata, err
+		}), (&retry_sdkv2.Attempt{}).ID(), middleware.After)
+	}
+}
+
+// done releases any concurrency slot held by the current attempt.
+func (s *operationStats) done() {
+	if s.release != nil {
+		s.release()
+		s.release = nil
+	}
+}
+
+func logStats(ctx context.Context, service, operation string, l *Limiter, stats *operationStats) {
+	total := l.Stats()
+
+	tflog.Debug(ctx, "AWS API call rate limiting", map[string]any{
+		"aws.service":                service,
+		"aws.operation":              operation,
+		"rate_limit.wait_ms":         stats.waited.Milliseconds(),
+		"rate_limit.throttled":       stats.throttled,
+		"rate_limit.total_requests":  total.Requests,
+		"rate_limit.total_throttled": total.Throttled,
+		"rate_limit.total_wait_ms":   total.Waited.Milliseconds(),
+	})
+}
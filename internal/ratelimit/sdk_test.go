@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting/unit"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func TestSDKv1HandlersRelease(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		send func(*request.Request)
+	}{
+		"presign": {},
+		"success": {
+			send: func(r *request.Request) {
+				r.HTTPResponse = &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{},
+					Body:       io.NopCloser(strings.NewReader(`<GetCallerIdentityResponse><GetCallerIdentityResult></GetCallerIdentityResult></GetCallerIdentityResponse>`)),
+				}
+			},
+		},
+		"send error": {
+			send: func(r *request.Request) {
+				r.Error = errors.New("connection refused")
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			l := New(0, 0, 1)
+			sess := unit.Session.Copy()
+			sess.Handlers.Send.Clear()
+			sess.Handlers.Retry.Clear()
+			AddSDKv1Handlers(&sess.Handlers, "sts", l)
+
+			var inFlight bool
+			sess.Handlers.Send.PushBack(func(r *request.Request) {
+				if slotAvailable(l) {
+					t.Error("expected the attempt to hold the concurrency slot")
+				}
+				inFlight = true
+				testCase.send(r)
+			})
+
+			req, _ := sts.New(sess).GetCallerIdentityRequest(&sts.GetCallerIdentityInput{})
+
+			if testCase.send == nil {
+				if _, err := req.Presign(time.Minute); err != nil {
+					t.Fatalf("presigning: %s", err)
+				}
+			} else {
+				req.Send() //nolint:errcheck // The result is not relevant.
+
+				if !inFlight {
+					t.Fatal("request was not sent")
+				}
+			}
+
+			if !slotAvailable(l) {
+				t.Error("concurrency slot was not released")
+			}
+		})
+	}
+}
+
+// slotAvailable returns whether a request can be made through the Limiter without waiting for another to complete.
+func slotAvailable(l *Limiter) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	release, _, err := l.Wait(ctx)
+	if err != nil {
+		return false
+	}
+	release()
+
+	return true
+}
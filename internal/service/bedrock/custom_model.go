@@ -0,0 +1,722 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Custom Model")
+// @Tags(identifierAttribute="custom_model_arn")
+func newResourceCustomModel(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceCustomModel{}
+	r.SetDefaultCreateTimeout(120 * time.Minute)
+	r.SetDefaultDeleteTimeout(20 * time.Minute)
+
+	return r, nil
+}
+
+type resourceCustomModel struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceCustomModel) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bedrock_custom_model"
+}
+
+func (r *resourceCustomModel) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	s3URIBlock := schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"s3_uri": schema.StringAttribute{
+					Required: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+		},
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"base_model_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_model_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"custom_model_kms_key_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_model_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hyperparameters": schema.MapAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"job_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"job_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"job_status": schema.StringAttribute{
+				Computed: true,
+			},
+			"role_arn": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"training_metrics": schema.ListAttribute{
+				Computed: true,
+				ElementType: types.ObjectType{
+					AttrTypes: trainingMetricsAttrTypes,
+				},
+			},
+			"validation_metrics": schema.ListAttribute{
+				Computed: true,
+				ElementType: types.ObjectType{
+					AttrTypes: validationMetricsAttrTypes,
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"output_data_config": s3URIBlock,
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+			"training_data_config": s3URIBlock,
+			"validation_data_config": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"validator": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"s3_uri": schema.StringAttribute{
+										Required: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+								},
+							},
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(10),
+							},
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			"vpc_config": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"security_group_ids": schema.SetAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.RequiresReplace(),
+							},
+						},
+						"subnet_ids": schema.SetAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceCustomModel) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceCustomModelData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	input := &bedrock.CreateModelCustomizationJobInput{
+		BaseModelIdentifier:  flex.StringFromFramework(ctx, data.BaseModelIdentifier),
+		ClientRequestToken:   aws.String(id.UniqueId()),
+		CustomModelKmsKeyId:  flex.StringFromFramework(ctx, data.CustomModelKmsKeyID),
+		CustomModelName:      flex.StringFromFramework(ctx, data.CustomModelName),
+		CustomModelTags:      getTagsIn(ctx),
+		HyperParameters:      flex.ExpandFrameworkStringValueMap(ctx, data.Hyperparameters),
+		JobName:              flex.StringFromFramework(ctx, data.JobName),
+		JobTags:              getTagsIn(ctx),
+		OutputDataConfig:     flex.ExpandFrameworkListNestedBlockPtr(ctx, data.OutputDataConfig, r.expandOutputDataConfig),
+		RoleArn:              flex.StringFromFramework(ctx, data.RoleARN),
+		TrainingDataConfig:   flex.ExpandFrameworkListNestedBlockPtr(ctx, data.TrainingDataConfig, r.expandTrainingDataConfig),
+		ValidationDataConfig: flex.ExpandFrameworkListNestedBlockPtr(ctx, data.ValidationDataConfig, r.expandValidationDataConfig),
+		VpcConfig:            flex.ExpandFrameworkListNestedBlockPtr(ctx, data.VPCConfig, r.expandVPCConfig),
+	}
+
+	output, err := conn.CreateModelCustomizationJob(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Custom Model (%s) customization job", data.CustomModelName.ValueString()), err.Error())
+
+		return
+	}
+
+	jobARN := aws.ToString(output.JobArn)
+	data.ID = types.StringValue(jobARN)
+
+	job, err := waitModelCustomizationJobCompleted(ctx, conn, jobARN, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		// Persist the (tainted) job so that it is stopped on destroy.
+		data.CustomModelARN = types.StringNull()
+		data.JobARN = data.ID
+		data.JobStatus = types.StringNull()
+		data.TrainingMetrics = flattenTrainingMetrics(ctx, nil)
+		data.ValidationMetrics = flattenValidationMetrics(ctx, nil)
+		response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Custom Model customization job (%s) complete", jobARN), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.CustomModelARN = flex.StringToFramework(ctx, job.OutputModelArn)
+	data.JobARN = flex.StringToFramework(ctx, job.JobArn)
+	data.JobStatus = flex.StringValueToFramework(ctx, job.Status)
+	data.TrainingMetrics = flattenTrainingMetrics(ctx, job.TrainingMetrics)
+	data.ValidationMetrics = flattenValidationMetrics(ctx, job.ValidationMetrics)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceCustomModel) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceCustomModelData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	jobARN := data.ID.ValueString()
+	job, err := findModelCustomizationJobByID(ctx, conn, jobARN)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Custom Model customization job (%s)", jobARN), err.Error())
+
+		return
+	}
+
+	// The API returns ARNs for identifiers that may have been configured as IDs or aliases.
+	if data.BaseModelIdentifier.IsNull() {
+		data.BaseModelIdentifier = flex.StringToFramework(ctx, job.BaseModelArn)
+	}
+	if data.CustomModelKmsKeyID.IsNull() {
+		data.CustomModelKmsKeyID = flex.StringToFramework(ctx, job.OutputModelKmsKeyArn)
+	}
+	data.CustomModelARN = flex.StringToFramework(ctx, job.OutputModelArn)
+	data.CustomModelName = flex.StringToFramework(ctx, job.OutputModelName)
+	data.Hyperparameters = flex.FlattenFrameworkStringValueMapLegacy(ctx, job.HyperParameters)
+	data.JobARN = flex.StringToFramework(ctx, job.JobArn)
+	data.JobName = flex.StringToFramework(ctx, job.JobName)
+	data.JobStatus = flex.StringValueToFramework(ctx, job.Status)
+	data.OutputDataConfig = r.flattenOutputDataConfig(ctx, job.OutputDataConfig)
+	data.RoleARN = flex.StringToFramework(ctx, job.RoleArn)
+	data.TrainingDataConfig = r.flattenTrainingDataConfig(ctx, job.TrainingDataConfig)
+	data.TrainingMetrics = flattenTrainingMetrics(ctx, job.TrainingMetrics)
+	data.ValidationDataConfig = r.flattenValidationDataConfig(ctx, job.ValidationDataConfig)
+	data.ValidationMetrics = flattenValidationMetrics(ctx, job.ValidationMetrics)
+	data.VPCConfig = r.flattenVPCConfig(ctx, job.VpcConfig)
+
+	// The job outlives the model it produced. If the model has been deleted, so has the resource.
+	if job.Status == awstypes.ModelCustomizationJobStatusCompleted {
+		modelARN := aws.ToString(job.OutputModelArn)
+		_, err := findCustomModelByID(ctx, conn, modelARN)
+
+		if tfresource.NotFound(err) {
+			response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+			response.State.RemoveResource(ctx)
+
+			return
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Custom Model (%s)", modelARN), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceCustomModel) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Tags only.
+}
+
+func (r *resourceCustomModel) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceCustomModelData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	jobARN := data.ID.ValueString()
+	job, err := findModelCustomizationJobByID(ctx, conn, jobARN)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Custom Model customization job (%s)", jobARN), err.Error())
+
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, data.Timeouts)
+
+	if job.Status == awstypes.ModelCustomizationJobStatusInProgress {
+		tflog.Debug(ctx, "stopping Bedrock Custom Model customization job", map[string]interface{}{
+			"job_arn": jobARN,
+		})
+		_, err := conn.StopModelCustomizationJob(ctx, &bedrock.StopModelCustomizationJobInput{
+			JobIdentifier: aws.String(jobARN),
+		})
+
+		if err != nil && !errs.IsA[*awstypes.ValidationException](err) {
+			response.Diagnostics.AddError(fmt.Sprintf("stopping Bedrock Custom Model customization job (%s)", jobARN), err.Error())
+
+			return
+		}
+	}
+
+	job, err = waitModelCustomizationJobStopped(ctx, conn, jobARN, deleteTimeout)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Custom Model customization job (%s) stop", jobARN), err.Error())
+
+		return
+	}
+
+	if job.Status != awstypes.ModelCustomizationJobStatusCompleted {
+		return
+	}
+
+	modelARN := aws.ToString(job.OutputModelArn)
+
+	tflog.Debug(ctx, "deleting Bedrock Custom Model", map[string]interface{}{
+		"model_arn": modelARN,
+	})
+	_, err = conn.DeleteCustomModel(ctx, &bedrock.DeleteCustomModelInput{
+		ModelIdentifier: aws.String(modelARN),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Custom Model (%s)", modelARN), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceCustomModel) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func (r *resourceCustomModel) expandOutputDataConfig(ctx context.Context, data customModelS3URIData) *awstypes.OutputDataConfig {
+	return &awstypes.OutputDataConfig{
+		S3Uri: flex.StringFromFramework(ctx, data.S3URI),
+	}
+}
+
+func (r *resourceCustomModel) flattenOutputDataConfig(ctx context.Context, apiObject *awstypes.OutputDataConfig) types.List {
+	if apiObject == nil {
+		return flattenCustomModelS3URI(ctx, nil)
+	}
+
+	return flattenCustomModelS3URI(ctx, apiObject.S3Uri)
+}
+
+func (r *resourceCustomModel) expandTrainingDataConfig(ctx context.Context, data customModelS3URIData) *awstypes.TrainingDataConfig {
+	return &awstypes.TrainingDataConfig{
+		S3Uri: flex.StringFromFramework(ctx, data.S3URI),
+	}
+}
+
+func (r *resourceCustomModel) flattenTrainingDataConfig(ctx context.Context, apiObject *awstypes.TrainingDataConfig) types.List {
+	if apiObject == nil {
+		return flattenCustomModelS3URI(ctx, nil)
+	}
+
+	return flattenCustomModelS3URI(ctx, apiObject.S3Uri)
+}
+
+func (r *resourceCustomModel) expandValidationDataConfig(ctx context.Context, data customModelValidationDataConfigData) *awstypes.ValidationDataConfig {
+	return &awstypes.ValidationDataConfig{
+		Validators: flex.ExpandFrameworkListNestedBlock(ctx, data.Validators, r.expandValidator),
+	}
+}
+
+func (r *resourceCustomModel) flattenValidationDataConfig(ctx context.Context, apiObject *awstypes.ValidationDataConfig) types.List {
+	elementType := types.ObjectType{AttrTypes: customModelValidationDataConfigAttrTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elementType)
+	}
+
+	return types.ListValueMust(elementType, []attr.Value{
+		types.ObjectValueMust(customModelValidationDataConfigAttrTypes, map[string]attr.Value{
+			"validator": flex.FlattenFrameworkListNestedBlock(ctx, apiObject.Validators, r.flattenValidator),
+		}),
+	})
+}
+
+func (r *resourceCustomModel) expandValidator(ctx context.Context, data customModelS3URIData) awstypes.Validator {
+	return awstypes.Validator{
+		S3Uri: flex.StringFromFramework(ctx, data.S3URI),
+	}
+}
+
+func (r *resourceCustomModel) flattenValidator(ctx context.Context, apiObject awstypes.Validator) customModelS3URIData {
+	return customModelS3URIData{
+		S3URI: flex.StringToFramework(ctx, apiObject.S3Uri),
+	}
+}
+
+func (r *resourceCustomModel) expandVPCConfig(ctx context.Context, data customModelVPCConfigData) *awstypes.VpcConfig {
+	return &awstypes.VpcConfig{
+		SecurityGroupIds: flex.ExpandFrameworkStringValueSet(ctx, data.SecurityGroupIDs),
+		SubnetIds:        flex.ExpandFrameworkStringValueSet(ctx, data.SubnetIDs),
+	}
+}
+
+func (r *resourceCustomModel) flattenVPCConfig(ctx context.Context, apiObject *awstypes.VpcConfig) types.List {
+	elementType := types.ObjectType{AttrTypes: customModelVPCConfigAttrTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elementType)
+	}
+
+	return types.ListValueMust(elementType, []attr.Value{
+		types.ObjectValueMust(customModelVPCConfigAttrTypes, map[string]attr.Value{
+			"security_group_ids": flex.FlattenFrameworkStringValueSet(ctx, apiObject.SecurityGroupIds),
+			"subnet_ids":         flex.FlattenFrameworkStringValueSet(ctx, apiObject.SubnetIds),
+		}),
+	})
+}
+
+func flattenCustomModelS3URI(ctx context.Context, s3URI *string) types.List {
+	elementType := types.ObjectType{AttrTypes: customModelS3URIAttrTypes}
+
+	if s3URI == nil {
+		return types.ListNull(elementType)
+	}
+
+	return types.ListValueMust(elementType, []attr.Value{
+		types.ObjectValueMust(customModelS3URIAttrTypes, map[string]attr.Value{
+			"s3_uri": flex.StringToFramework(ctx, s3URI),
+		}),
+	})
+}
+
+func flattenTrainingMetrics(ctx context.Context, apiObject *awstypes.TrainingMetrics) types.List {
+	elementType := types.ObjectType{AttrTypes: trainingMetricsAttrTypes}
+
+	if apiObject == nil {
+		return types.ListValueMust(elementType, []attr.Value{})
+	}
+
+	return types.ListValueMust(elementType, []attr.Value{
+		types.ObjectValueMust(trainingMetricsAttrTypes, map[string]attr.Value{
+			"training_loss": float32ToFramework(apiObject.TrainingLoss),
+		}),
+	})
+}
+
+func flattenValidationMetrics(ctx context.Context, apiObjects []awstypes.ValidatorMetric) types.List {
+	elementType := types.ObjectType{AttrTypes: validationMetricsAttrTypes}
+
+	elems := []attr.Value{}
+	for _, apiObject := range apiObjects {
+		elems = append(elems, types.ObjectValueMust(validationMetricsAttrTypes, map[string]attr.Value{
+			"validation_loss": float32ToFramework(apiObject.ValidationLoss),
+		}))
+	}
+
+	return types.ListValueMust(elementType, elems)
+}
+
+func float32ToFramework(v *float32) types.Float64 {
+	if v == nil {
+		return types.Float64Null()
+	}
+
+	return types.Float64Value(float64(aws.ToFloat32(v)))
+}
+
+type resourceCustomModelData struct {
+	BaseModelIdentifier  types.String   `tfsdk:"base_model_identifier"`
+	CustomModelARN       types.String   `tfsdk:"custom_model_arn"`
+	CustomModelKmsKeyID  types.String   `tfsdk:"custom_model_kms_key_id"`
+	CustomModelName      types.String   `tfsdk:"custom_model_name"`
+	Hyperparameters      types.Map      `tfsdk:"hyperparameters"`
+	ID                   types.String   `tfsdk:"id"`
+	JobARN               types.String   `tfsdk:"job_arn"`
+	JobName              types.String   `tfsdk:"job_name"`
+	JobStatus            types.String   `tfsdk:"job_status"`
+	OutputDataConfig     types.List     `tfsdk:"output_data_config"`
+	RoleARN              types.String   `tfsdk:"role_arn"`
+	Tags                 types.Map      `tfsdk:"tags"`
+	TagsAll              types.Map      `tfsdk:"tags_all"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+	TrainingDataConfig   types.List     `tfsdk:"training_data_config"`
+	TrainingMetrics      types.List     `tfsdk:"training_metrics"`
+	ValidationDataConfig types.List     `tfsdk:"validation_data_config"`
+	ValidationMetrics    types.List     `tfsdk:"validation_metrics"`
+	VPCConfig            types.List     `tfsdk:"vpc_config"`
+}
+
+type customModelS3URIData struct {
+	S3URI types.String `tfsdk:"s3_uri"`
+}
+
+type customModelValidationDataConfigData struct {
+	Validators types.List `tfsdk:"validator"`
+}
+
+type customModelVPCConfigData struct {
+	SecurityGroupIDs types.Set `tfsdk:"security_group_ids"`
+	SubnetIDs        types.Set `tfsdk:"subnet_ids"`
+}
+
+var (
+	customModelS3URIAttrTypes = map[string]attr.Type{
+		"s3_uri": types.StringType,
+	}
+	customModelValidationDataConfigAttrTypes = map[string]attr.Type{
+		"validator": types.ListType{ElemType: types.ObjectType{AttrTypes: customModelS3URIAttrTypes}},
+	}
+	customModelVPCConfigAttrTypes = map[string]attr.Type{
+		"security_group_ids": types.SetType{ElemType: types.StringType},
+		"subnet_ids":         types.SetType{ElemType: types.StringType},
+	}
+	trainingMetricsAttrTypes = map[string]attr.Type{
+		"training_loss": types.Float64Type,
+	}
+	validationMetricsAttrTypes = map[string]attr.Type{
+		"validation_loss": types.Float64Type,
+	}
+)
+
+func findModelCustomizationJobByID(ctx context.Context, conn *bedrock.Client, id string) (*bedrock.GetModelCustomizationJobOutput, error) {
+	input := &bedrock.GetModelCustomizationJobInput{
+		JobIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetModelCustomizationJob(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findCustomModelByID(ctx context.Context, conn *bedrock.Client, id string) (*bedrock.GetCustomModelOutput, error) {
+	input := &bedrock.GetCustomModelInput{
+		ModelIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetCustomModel(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusModelCustomizationJob(ctx context.Context, conn *bedrock.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findModelCustomizationJobByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitModelCustomizationJobCompleted(ctx context.Context, conn *bedrock.Client, id string, timeout time.Duration) (*bedrock.GetModelCustomizationJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ModelCustomizationJobStatusInProgress),
+		Target:  enum.Slice(awstypes.ModelCustomizationJobStatusCompleted),
+		Refresh: statusModelCustomizationJob(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetModelCustomizationJobOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitModelCustomizationJobStopped(ctx context.Context, conn *bedrock.Client, id string, timeout time.Duration) (*bedrock.GetModelCustomizationJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ModelCustomizationJobStatusInProgress, awstypes.ModelCustomizationJobStatusStopping),
+		Target:  enum.Slice(awstypes.ModelCustomizationJobStatusCompleted, awstypes.ModelCustomizationJobStatusFailed, awstypes.ModelCustomizationJobStatusStopped),
+		Refresh: statusModelCustomizationJob(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetModelCustomizationJobOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
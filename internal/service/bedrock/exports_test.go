@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+// Exports for use in tests only.
+var (
+	ResourceCustomModel                = newResourceCustomModel
+	ResourceProvisionedModelThroughput = newResourceProvisionedModelThroughput
+
+	FindCustomModelByID                = findCustomModelByID
+	FindModelCustomizationJobByID      = findModelCustomizationJobByID
+	FindProvisionedModelThroughputByID = findProvisionedModelThroughputByID
+)
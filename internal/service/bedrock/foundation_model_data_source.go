@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Foundation Model")
+func newDataSourceFoundationModel(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceFoundationModel{}, nil
+}
+
+type dataSourceFoundationModel struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceFoundationModel) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_bedrock_foundation_model"
+}
+
+func (d *dataSourceFoundationModel) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"customizations_supported": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"inference_types_supported": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"input_modalities": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"model_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"model_id": schema.StringAttribute{
+				Required: true,
+			},
+			"model_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"output_modalities": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"provider_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"response_streaming_supported": schema.BoolAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceFoundationModel) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceFoundationModelData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().BedrockClient(ctx)
+
+	modelID := data.ModelID.ValueString()
+	model, err := findFoundationModelByID(ctx, conn, modelID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Foundation Model (%s)", modelID), err.Error())
+
+		return
+	}
+
+	data.ID = flex.StringToFramework(ctx, model.ModelId)
+	data.ModelARN = flex.StringToFramework(ctx, model.ModelArn)
+	data.ModelName = flex.StringToFramework(ctx, model.ModelName)
+	data.ProviderName = flex.StringToFramework(ctx, model.ProviderName)
+	data.CustomizationsSupported = flex.FlattenFrameworkStringValueSet(ctx, enum.Slice(model.CustomizationsSupported...))
+	data.InferenceTypesSupported = flex.FlattenFrameworkStringValueSet(ctx, enum.Slice(model.InferenceTypesSupported...))
+	data.InputModalities = flex.FlattenFrameworkStringValueSet(ctx, enum.Slice(model.InputModalities...))
+	data.OutputModalities = flex.FlattenFrameworkStringValueSet(ctx, enum.Slice(model.OutputModalities...))
+	data.ResponseStreamingSupported = flex.BoolToFramework(ctx, model.ResponseStreamingSupported)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceFoundationModelData struct {
+	CustomizationsSupported    types.Set    `tfsdk:"customizations_supported"`
+	ID                         types.String `tfsdk:"id"`
+	InferenceTypesSupported    types.Set    `tfsdk:"inference_types_supported"`
+	InputModalities            types.Set    `tfsdk:"input_modalities"`
+	ModelARN                   types.String `tfsdk:"model_arn"`
+	ModelID                    types.String `tfsdk:"model_id"`
+	ModelName                  types.String `tfsdk:"model_name"`
+	OutputModalities           types.Set    `tfsdk:"output_modalities"`
+	ProviderName               types.String `tfsdk:"provider_name"`
+	ResponseStreamingSupported types.Bool   `tfsdk:"response_streaming_supported"`
+}
+
+func findFoundationModelByID(ctx context.Context, conn *bedrock.Client, id string) (*awstypes.FoundationModelDetails, error) {
+	input := &bedrock.GetFoundationModelInput{
+		ModelIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetFoundationModel(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ModelDetails == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ModelDetails, nil
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBedrockFoundationModelDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_bedrock_foundation_model.test"
+	modelID := "amazon.titan-text-express-v1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFoundationModelDataSourceConfig_basic(modelID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "id", modelID),
+					resource.TestCheckResourceAttr(datasourceName, "model_id", modelID),
+					acctest.CheckResourceAttrRegionalARNNoAccount(datasourceName, "model_arn", "bedrock", "foundation-model/"+modelID),
+					resource.TestCheckResourceAttrSet(datasourceName, "model_name"),
+					resource.TestCheckResourceAttr(datasourceName, "provider_name", "Amazon"),
+					resource.TestCheckResourceAttrSet(datasourceName, "customizations_supported.#"),
+					resource.TestCheckResourceAttrSet(datasourceName, "inference_types_supported.#"),
+					resource.TestCheckResourceAttrSet(datasourceName, "input_modalities.#"),
+					resource.TestCheckResourceAttrSet(datasourceName, "output_modalities.#"),
+					resource.TestCheckResourceAttr(datasourceName, "response_streaming_supported", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFoundationModelDataSourceConfig_basic(modelID string) string {
+	return fmt.Sprintf(`
+data "aws_bedrock_foundation_model" "test" {
+  model_id = %[1]q
+}
+`, modelID)
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	tfflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	foundationModelsIDPartCount = 5
+)
+
+// @FrameworkDataSource(name="Foundation Models")
+func newDataSourceFoundationModels(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceFoundationModels{}, nil
+}
+
+type dataSourceFoundationModels struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceFoundationModels) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_bedrock_foundation_models"
+}
+
+func (d *dataSourceFoundationModels) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"by_customization_type": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.ModelCustomization](),
+				},
+			},
+			"by_inference_type": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.InferenceType](),
+				},
+			},
+			"by_output_modality": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.ModelModality](),
+				},
+			},
+			"by_provider": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z0-9-]{1,63}$`), "must be 1-63 lowercase letters, digits or hyphens"),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			// Nested attributes aren't supported over protocol version 5.
+			"model_summaries": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.ObjectType{AttrTypes: foundationModelSummaryAttrTypes},
+			},
+		},
+	}
+}
+
+func (d *dataSourceFoundationModels) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceFoundationModelsData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().BedrockClient(ctx)
+
+	input := &bedrock.ListFoundationModelsInput{
+		ByCustomizationType: awstypes.ModelCustomization(data.ByCustomizationType.ValueString()),
+		ByInferenceType:     awstypes.InferenceType(data.ByInferenceType.ValueString()),
+		ByOutputModality:    awstypes.ModelModality(data.ByOutputModality.ValueString()),
+		ByProvider:          flex.StringFromFramework(ctx, data.ByProvider),
+	}
+
+	output, err := conn.ListFoundationModels(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("listing Bedrock Foundation Models", err.Error())
+
+		return
+	}
+
+	id, err := tfflex.FlattenResourceId([]string{
+		d.Meta().Region,
+		data.ByCustomizationType.ValueString(),
+		data.ByInferenceType.ValueString(),
+		data.ByOutputModality.ValueString(),
+		data.ByProvider.ValueString(),
+	}, foundationModelsIDPartCount, true)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Bedrock Foundation Models ID", err.Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(id)
+	modelSummaries, diags := flattenFoundationModelSummaries(ctx, output.ModelSummaries)
+	response.Diagnostics.Append(diags...)
+	data.ModelSummaries = modelSummaries
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func flattenFoundationModelSummaries(ctx context.Context, apiObjects []awstypes.FoundationModelSummary) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: foundationModelSummaryAttrTypes}
+
+	elems := []attr.Value{}
+	for _, apiObject := range apiObjects {
+		obj := map[string]attr.Value{
+			"customizations_supported":     flex.FlattenFrameworkStringValueSet(ctx, enum.Slice(apiObject.CustomizationsSupported...)),
+			"inference_types_supported":    flex.FlattenFrameworkStringValueSet(ctx, enum.Slice(apiObject.InferenceTypesSupported...)),
+			"input_modalities":             flex.FlattenFrameworkStringValueSet(ctx, enum.Slice(apiObject.InputModalities...)),
+			"model_arn":                    flex.StringToFramework(ctx, apiObject.ModelArn),
+			"model_id":                     flex.StringToFramework(ctx, apiObject.ModelId),
+			"model_name":                   flex.StringToFramework(ctx, apiObject.ModelName),
+			"output_modalities":            flex.FlattenFrameworkStringValueSet(ctx, enum.Slice(apiObject.OutputModalities...)),
+			"provider_name":                flex.StringToFramework(ctx, apiObject.ProviderName),
+			"response_streaming_supported": flex.BoolToFramework(ctx, apiObject.ResponseStreamingSupported),
+		}
+		objVal, d := types.ObjectValue(foundationModelSummaryAttrTypes, obj)
+		diags.Append(d...)
+
+		elems = append(elems, objVal)
+	}
+	listVal, d := types.ListValue(elemType, elems)
+	diags.Append(d...)
+
+	return listVal, diags
+}
+
+type dataSourceFoundationModelsData struct {
+	ByCustomizationType types.String `tfsdk:"by_customization_type"`
+	ByInferenceType     types.String `tfsdk:"by_inference_type"`
+	ByOutputModality    types.String `tfsdk:"by_output_modality"`
+	ByProvider          types.String `tfsdk:"by_provider"`
+	ID                  types.String `tfsdk:"id"`
+	ModelSummaries      types.List   `tfsdk:"model_summaries"`
+}
+
+var foundationModelSummaryAttrTypes = map[string]attr.Type{
+	"customizations_supported":     types.SetType{ElemType: types.StringType},
+	"inference_types_supported":    types.SetType{ElemType: types.StringType},
+	"input_modalities":             types.SetType{ElemType: types.StringType},
+	"model_arn":                    types.StringType,
+	"model_id":                     types.StringType,
+	"model_name":                   types.StringType,
+	"output_modalities":            types.SetType{ElemType: types.StringType},
+	"provider_name":                types.StringType,
+	"response_streaming_supported": types.BoolType,
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBedrockFoundationModelsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_bedrock_foundation_models.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFoundationModelsDataSourceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "id"),
+					acctest.CheckResourceAttrGreaterThanValue(datasourceName, "model_summaries.#", 0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBedrockFoundationModelsDataSource_byCustomizationType(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_bedrock_foundation_models.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFoundationModelsDataSourceConfig_byCustomizationType("FINE_TUNING"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "id", acctest.Region()+",FINE_TUNING,,,"),
+					acctest.CheckResourceAttrGreaterThanValue(datasourceName, "model_summaries.#", 0),
+					resource.TestCheckTypeSetElemAttr(datasourceName, "model_summaries.0.customizations_supported.*", "FINE_TUNING"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBedrockFoundationModelsDataSource_byProvider(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_bedrock_foundation_models.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFoundationModelsDataSourceConfig_byProvider("amazon"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "id", acctest.Region()+",,,,amazon"),
+					acctest.CheckResourceAttrGreaterThanValue(datasourceName, "model_summaries.#", 0),
+					resource.TestCheckResourceAttr(datasourceName, "model_summaries.0.provider_name", "Amazon"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFoundationModelsDataSourceConfig_basic() string {
+	return `
+data "aws_bedrock_foundation_models" "test" {}
+`
+}
+
+func testAccFoundationModelsDataSourceConfig_byCustomizationType(customizationType string) string {
+	return fmt.Sprintf(`
+data "aws_bedrock_foundation_models" "test" {
+  by_customization_type = %[1]q
+}
+`, customizationType)
+}
+
+func testAccFoundationModelsDataSourceConfig_byProvider(provider string) string {
+	return fmt.Sprintf(`
+data "aws_bedrock_foundation_models" "test" {
+  by_provider = %[1]q
+}
+`, provider)
+}
@@ -0,0 +1,333 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Provisioned Model Throughput")
+// @Tags(identifierAttribute="provisioned_model_arn")
+func newResourceProvisionedModelThroughput(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceProvisionedModelThroughput{}
+	r.SetDefaultCreateTimeout(20 * time.Minute)
+	r.SetDefaultUpdateTimeout(20 * time.Minute)
+
+	return r, nil
+}
+
+type resourceProvisionedModelThroughput struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceProvisionedModelThroughput) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bedrock_provisioned_model_throughput"
+}
+
+func (r *resourceProvisionedModelThroughput) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"commitment_duration": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.CommitmentDuration](),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"model_arn": schema.StringAttribute{
+				Required: true,
+			},
+			"model_units": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"provisioned_model_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"provisioned_model_name": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceProvisionedModelThroughput) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceProvisionedModelThroughputData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	name := data.ProvisionedModelName.ValueString()
+	input := &bedrock.CreateProvisionedModelThroughputInput{
+		ClientRequestToken:   aws.String(id.UniqueId()),
+		CommitmentDuration:   awstypes.CommitmentDuration(data.CommitmentDuration.ValueString()),
+		ModelId:              flex.StringFromFramework(ctx, data.ModelARN),
+		ModelUnits:           aws.Int32(int32(data.ModelUnits.ValueInt64())),
+		ProvisionedModelName: aws.String(name),
+		Tags:                 getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateProvisionedModelThroughput(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Provisioned Model Throughput (%s)", name), err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.ProvisionedModelArn)
+
+	if _, err := waitProvisionedModelThroughputInService(ctx, conn, arn, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Provisioned Model Throughput (%s) create", arn), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(arn)
+	data.ProvisionedModelARN = types.StringValue(arn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceProvisionedModelThroughput) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceProvisionedModelThroughputData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	output, err := findProvisionedModelThroughputByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Provisioned Model Throughput (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.CommitmentDuration = flex.StringValueToFramework(ctx, output.CommitmentDuration)
+	data.ModelARN = flex.StringToFramework(ctx, output.ModelArn)
+	data.ModelUnits = types.Int64Value(int64(aws.ToInt32(output.ModelUnits)))
+	data.ProvisionedModelARN = flex.StringToFramework(ctx, output.ProvisionedModelArn)
+	data.ProvisionedModelName = flex.StringToFramework(ctx, output.ProvisionedModelName)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceProvisionedModelThroughput) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceProvisionedModelThroughputData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	if !new.ModelARN.Equal(old.ModelARN) || !new.ProvisionedModelName.Equal(old.ProvisionedModelName) {
+		input := &bedrock.UpdateProvisionedModelThroughputInput{
+			ProvisionedModelId: flex.StringFromFramework(ctx, new.ID),
+		}
+
+		if !new.ModelARN.Equal(old.ModelARN) {
+			input.DesiredModelId = flex.StringFromFramework(ctx, new.ModelARN)
+		}
+
+		if !new.ProvisionedModelName.Equal(old.ProvisionedModelName) {
+			input.DesiredProvisionedModelName = flex.StringFromFramework(ctx, new.ProvisionedModelName)
+		}
+
+		_, err := conn.UpdateProvisionedModelThroughput(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Provisioned Model Throughput (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitProvisionedModelThroughputInService(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Provisioned Model Throughput (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceProvisionedModelThroughput) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceProvisionedModelThroughputData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	tflog.Debug(ctx, "deleting Bedrock Provisioned Model Throughput", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeleteProvisionedModelThroughput(ctx, &bedrock.DeleteProvisionedModelThroughputInput{
+		ProvisionedModelId: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Provisioned Model Throughput (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceProvisionedModelThroughput) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type resourceProvisionedModelThroughputData struct {
+	CommitmentDuration   types.String   `tfsdk:"commitment_duration"`
+	ID                   types.String   `tfsdk:"id"`
+	ModelARN             types.String   `tfsdk:"model_arn"`
+	ModelUnits           types.Int64    `tfsdk:"model_units"`
+	ProvisionedModelARN  types.String   `tfsdk:"provisioned_model_arn"`
+	ProvisionedModelName types.String   `tfsdk:"provisioned_model_name"`
+	Tags                 types.Map      `tfsdk:"tags"`
+	TagsAll              types.Map      `tfsdk:"tags_all"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+}
+
+func findProvisionedModelThroughputByID(ctx context.Context, conn *bedrock.Client, id string) (*bedrock.GetProvisionedModelThroughputOutput, error) {
+	input := &bedrock.GetProvisionedModelThroughputInput{
+		ProvisionedModelId: aws.String(id),
+	}
+
+	output, err := conn.GetProvisionedModelThroughput(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusProvisionedModelThroughput(ctx context.Context, conn *bedrock.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findProvisionedModelThroughputByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitProvisionedModelThroughputInService(ctx context.Context, conn *bedrock.Client, id string, timeout time.Duration) (*bedrock.GetProvisionedModelThroughputOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ProvisionedModelStatusCreating, awstypes.ProvisionedModelStatusUpdating),
+		Target:  enum.Slice(awstypes.ProvisionedModelStatusInService),
+		Refresh: statusProvisionedModelThroughput(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetProvisionedModelThroughputOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
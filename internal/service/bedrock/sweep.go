@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build sweep
+// +build sweep
+
+package bedrock
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_bedrock_custom_model", &resource.Sweeper{
+		Name: "aws_bedrock_custom_model",
+		F:    sweepCustomModels,
+		Dependencies: []string{
+			"aws_bedrock_provisioned_model_throughput",
+		},
+	})
+	sweep.AddTestSweepers("aws_bedrock_provisioned_model_throughput", &resource.Sweeper{
+		Name: "aws_bedrock_provisioned_model_throughput",
+		F:    sweepProvisionedModelThroughputs,
+	})
+}
+
+func sweepCustomModels(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.BedrockClient(ctx)
+	input := &bedrock.ListModelCustomizationJobsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := bedrock.NewListModelCustomizationJobsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Bedrock Custom Model sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Bedrock Model Customization Jobs (%s): %w", region, err)
+		}
+
+		for _, v := range page.ModelCustomizationJobSummaries {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newResourceCustomModel, client,
+				framework.NewAttribute("id", aws.ToString(v.JobArn)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Bedrock Custom Models (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepProvisionedModelThroughputs(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.BedrockClient(ctx)
+	input := &bedrock.ListProvisionedModelThroughputsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := bedrock.NewListProvisionedModelThroughputsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Bedrock Provisioned Model Throughput sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Bedrock Provisioned Model Throughputs (%s): %w", region, err)
+		}
+
+		for _, v := range page.ProvisionedModelSummaries {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newResourceProvisionedModelThroughput, client,
+				framework.NewAttribute("id", aws.ToString(v.ProvisionedModelArn)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Bedrock Provisioned Model Throughputs (%s): %w", region, err)
+	}
+
+	return nil
+}
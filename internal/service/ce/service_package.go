@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ce
+
+// IsGlobal returns true as Cost Explorer resources are not Regional.
+func (p *servicePackage) IsGlobal() bool {
+	return true
+}
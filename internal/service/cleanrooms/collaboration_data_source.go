@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cleanrooms_collaboration")
+func DataSourceCollaboration() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCollaborationRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creator_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creator_display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_encryption_metadata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_clear_text": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"allow_duplicates": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"allow_joins_on_columns_with_different_names": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"preserve_nulls": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"member_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"membership_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"membership_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"query_log_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	DSNameCollaboration = "Collaboration Data Source"
+)
+
+func dataSourceCollaborationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	id := d.Get("collaboration_id").(string)
+	out, err := findCollaborationByID(ctx, conn, id)
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionReading, DSNameCollaboration, id, err)
+	}
+
+	collaboration := out.Collaboration
+	d.SetId(aws.ToString(collaboration.Id))
+	d.Set(names.AttrARN, collaboration.Arn)
+	d.Set("create_time", collaboration.CreateTime.String())
+	d.Set("creator_account_id", collaboration.CreatorAccountId)
+	d.Set("creator_display_name", collaboration.CreatorDisplayName)
+	if err := d.Set("data_encryption_metadata", flattenDataEncryptionMetadata(collaboration.DataEncryptionMetadata)); err != nil {
+		return diag.Errorf("setting data_encryption_metadata: %s", err)
+	}
+	d.Set(names.AttrDescription, collaboration.Description)
+	d.Set("member_status", collaboration.MemberStatus)
+	d.Set("membership_arn", collaboration.MembershipArn)
+	d.Set("membership_id", collaboration.MembershipId)
+	d.Set(names.AttrName, collaboration.Name)
+	d.Set("query_log_status", collaboration.QueryLogStatus)
+	d.Set("update_time", collaboration.UpdateTime.String())
+
+	return nil
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsCollaborationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cleanrooms_collaboration.test"
+	resourceName := "aws_cleanrooms_collaboration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollaborationDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "collaboration_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "create_time", resourceName, "create_time"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "creator_display_name", resourceName, "creator_display_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "data_encryption_metadata.#", "0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttr(dataSourceName, "member_status", "INVITED"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "query_log_status", resourceName, "query_log_status"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsCollaborationDataSource_invited(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cleanrooms_collaboration.test"
+	listDataSourceName := "data.aws_cleanrooms_collaborations.test"
+	resourceName := "aws_cleanrooms_collaboration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollaborationDataSourceConfig_invited(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "creator_account_id", "data.aws_caller_identity.creator", "account_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "member_status", "INVITED"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckTypeSetElemAttrPair(listDataSourceName, "ids.*", resourceName, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(listDataSourceName, "collaborations.*", map[string]string{
+						"member_status": "INVITED",
+						"name":          rName,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCollaborationDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cleanrooms_collaboration" "test" {
+  name                     = %[1]q
+  creator_member_abilities = ["CAN_QUERY", "CAN_RECEIVE_RESULTS"]
+  creator_display_name     = "creator"
+  description              = %[1]q
+  query_log_status         = "DISABLED"
+}
+
+data "aws_cleanrooms_collaboration" "test" {
+  collaboration_id = aws_cleanrooms_collaboration.test.id
+}
+`, rName)
+}
+
+// The collaboration is created in the alternate account and invites the
+// primary account, which then discovers it through the data sources.
+func testAccCollaborationDataSourceConfig_invited(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_caller_identity" "creator" {
+  provider = "awsalternate"
+}
+
+resource "aws_cleanrooms_collaboration" "test" {
+  provider = "awsalternate"
+
+  name                     = %[1]q
+  creator_member_abilities = ["CAN_QUERY", "CAN_RECEIVE_RESULTS"]
+  creator_display_name     = "creator"
+  description              = %[1]q
+  query_log_status         = "DISABLED"
+
+  member {
+    account_id       = data.aws_caller_identity.current.account_id
+    display_name     = "partner"
+    member_abilities = []
+  }
+}
+
+data "aws_cleanrooms_collaboration" "test" {
+  collaboration_id = aws_cleanrooms_collaboration.test.id
+}
+
+data "aws_cleanrooms_collaborations" "test" {
+  member_status = "INVITED"
+
+  depends_on = [aws_cleanrooms_collaboration.test]
+}
+`, rName))
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cleanrooms_collaborations")
+func DataSourceCollaborations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCollaborationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"collaborations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creator_account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creator_display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"member_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"membership_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"membership_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"update_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"member_status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.FilterableMemberStatus](),
+			},
+		},
+	}
+}
+
+const (
+	DSNameCollaborations = "Collaborations Data Source"
+)
+
+func dataSourceCollaborationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	input := &cleanrooms.ListCollaborationsInput{}
+
+	if v, ok := d.GetOk("member_status"); ok {
+		input.MemberStatus = types.FilterableMemberStatus(v.(string))
+	}
+
+	var collaborations []interface{}
+	var ids []string
+
+	paginator := cleanrooms.NewListCollaborationsPaginator(conn, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return create.DiagError(names.CleanRooms, create.ErrActionReading, DSNameCollaborations, "", err)
+		}
+
+		for _, v := range page.CollaborationList {
+			collaborations = append(collaborations, flattenCollaborationSummary(v))
+			ids = append(ids, aws.ToString(v.Id))
+		}
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("collaborations", collaborations); err != nil {
+		return diag.Errorf("setting collaborations: %s", err)
+	}
+	d.Set("ids", ids)
+
+	return nil
+}
+
+func flattenCollaborationSummary(apiObject types.CollaborationSummary) map[string]interface{} {
+	return map[string]interface{}{
+		names.AttrARN:          aws.ToString(apiObject.Arn),
+		"create_time":          apiObject.CreateTime.String(),
+		"creator_account_id":   aws.ToString(apiObject.CreatorAccountId),
+		"creator_display_name": aws.ToString(apiObject.CreatorDisplayName),
+		names.AttrID:           aws.ToString(apiObject.Id),
+		"member_status":        string(apiObject.MemberStatus),
+		"membership_arn":       aws.ToString(apiObject.MembershipArn),
+		"membership_id":        aws.ToString(apiObject.MembershipId),
+		names.AttrName:         aws.ToString(apiObject.Name),
+		"update_time":          apiObject.UpdateTime.String(),
+	}
+}
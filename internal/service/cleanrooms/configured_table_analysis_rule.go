@@ -0,0 +1,489 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_configured_table_analysis_rule")
+func ResourceConfiguredTableAnalysisRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfiguredTableAnalysisRuleCreate,
+		ReadWithoutTimeout:   resourceConfiguredTableAnalysisRuleRead,
+		UpdateWithoutTimeout: resourceConfiguredTableAnalysisRuleUpdate,
+		DeleteWithoutTimeout: resourceConfiguredTableAnalysisRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aggregation": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"aggregation", "list"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aggregate_column": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column_names": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"function": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.AggregateFunctionName](),
+									},
+								},
+							},
+						},
+						"allowed_join_operators": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[types.JoinOperator](),
+							},
+						},
+						"dimension_columns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"join_columns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"join_required": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[types.JoinRequiredOption](),
+						},
+						"output_constraint": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"minimum": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(2),
+									},
+									"type": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.AggregationType](),
+									},
+								},
+							},
+						},
+						"scalar_functions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[types.ScalarFunctions](),
+							},
+						},
+					},
+				},
+			},
+			"analysis_rule_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configured_table_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configured_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"list": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"aggregation", "list"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_join_operators": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[types.JoinOperator](),
+							},
+						},
+						"join_columns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"list_columns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: resourceConfiguredTableAnalysisRuleCustomizeDiff,
+	}
+}
+
+const (
+	ResNameConfiguredTableAnalysisRule = "Configured Table Analysis Rule"
+
+	configuredTableAnalysisRuleIDPartCount = 2
+)
+
+// The analysis rule type is part of the resource's identity, so switching
+// between an aggregation and a list rule requires replacement.
+func resourceConfiguredTableAnalysisRuleCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChange("aggregation") {
+		if o, n := d.GetChange("aggregation"); len(o.([]interface{})) != len(n.([]interface{})) {
+			return d.ForceNew("aggregation")
+		}
+	}
+
+	if d.HasChange("list") {
+		if o, n := d.GetChange("list"); len(o.([]interface{})) != len(n.([]interface{})) {
+			return d.ForceNew("list")
+		}
+	}
+
+	return nil
+}
+
+func resourceConfiguredTableAnalysisRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	configuredTableID := d.Get("configured_table_id").(string)
+	ruleType, policy := expandConfiguredTableAnalysisRulePolicy(d)
+	input := &cleanrooms.CreateConfiguredTableAnalysisRuleInput{
+		AnalysisRulePolicy:        policy,
+		AnalysisRuleType:          ruleType,
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	out, err := conn.CreateConfiguredTableAnalysisRule(ctx, input)
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAnalysisRule, configuredTableID, err)
+	}
+
+	if out == nil || out.AnalysisRule == nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAnalysisRule, configuredTableID, errors.New("empty output"))
+	}
+
+	id, err := flex.FlattenResourceId([]string{configuredTableID, string(ruleType)}, configuredTableAnalysisRuleIDPartCount, false)
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAnalysisRule, configuredTableID, err)
+	}
+	d.SetId(id)
+
+	return resourceConfiguredTableAnalysisRuleRead(ctx, d, meta)
+}
+
+func resourceConfiguredTableAnalysisRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), configuredTableAnalysisRuleIDPartCount, false)
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionReading, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+	configuredTableID, ruleType := parts[0], types.ConfiguredTableAnalysisRuleType(parts[1])
+
+	analysisRule, err := findConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, configuredTableID, ruleType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CleanRooms Configured Table Analysis Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionReading, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	d.Set("analysis_rule_type", analysisRule.Type)
+	d.Set("configured_table_arn", analysisRule.ConfiguredTableArn)
+	d.Set("configured_table_id", analysisRule.ConfiguredTableId)
+	d.Set("create_time", analysisRule.CreateTime.String())
+	d.Set("update_time", analysisRule.UpdateTime.String())
+
+	aggregation, list := flattenConfiguredTableAnalysisRulePolicy(analysisRule.Policy)
+	if err := d.Set("aggregation", aggregation); err != nil {
+		return diag.Errorf("setting aggregation: %s", err)
+	}
+	if err := d.Set("list", list); err != nil {
+		return diag.Errorf("setting list: %s", err)
+	}
+
+	return nil
+}
+
+func resourceConfiguredTableAnalysisRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	ruleType, policy := expandConfiguredTableAnalysisRulePolicy(d)
+	input := &cleanrooms.UpdateConfiguredTableAnalysisRuleInput{
+		AnalysisRulePolicy:        policy,
+		AnalysisRuleType:          ruleType,
+		ConfiguredTableIdentifier: aws.String(d.Get("configured_table_id").(string)),
+	}
+
+	_, err := conn.UpdateConfiguredTableAnalysisRule(ctx, input)
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	return append(diags, resourceConfiguredTableAnalysisRuleRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableAnalysisRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), configuredTableAnalysisRuleIDPartCount, false)
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionDeleting, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting CleanRooms Configured Table Analysis Rule %s", d.Id())
+	_, err = conn.DeleteConfiguredTableAnalysisRule(ctx, &cleanrooms.DeleteConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          types.ConfiguredTableAnalysisRuleType(parts[1]),
+		ConfiguredTableIdentifier: aws.String(parts[0]),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionDeleting, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	return nil
+}
+
+func findConfiguredTableAnalysisRuleByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, configuredTableID string, ruleType types.ConfiguredTableAnalysisRuleType) (*types.ConfiguredTableAnalysisRule, error) {
+	in := &cleanrooms.GetConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          ruleType,
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+	out, err := conn.GetConfiguredTableAnalysisRule(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.AnalysisRule == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.AnalysisRule, nil
+}
+
+func expandConfiguredTableAnalysisRulePolicy(d *schema.ResourceData) (types.ConfiguredTableAnalysisRuleType, types.ConfiguredTableAnalysisRulePolicy) {
+	if v, ok := d.GetOk("aggregation"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		return types.ConfiguredTableAnalysisRuleTypeAggregation, &types.ConfiguredTableAnalysisRulePolicyMemberV1{
+			Value: &types.ConfiguredTableAnalysisRulePolicyV1MemberAggregation{
+				Value: expandAnalysisRuleAggregation(v.([]interface{})[0].(map[string]interface{})),
+			},
+		}
+	}
+
+	if v, ok := d.GetOk("list"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		return types.ConfiguredTableAnalysisRuleTypeList, &types.ConfiguredTableAnalysisRulePolicyMemberV1{
+			Value: &types.ConfiguredTableAnalysisRulePolicyV1MemberList{
+				Value: expandAnalysisRuleList(v.([]interface{})[0].(map[string]interface{})),
+			},
+		}
+	}
+
+	return "", nil
+}
+
+func expandAnalysisRuleAggregation(tfMap map[string]interface{}) types.AnalysisRuleAggregation {
+	apiObject := types.AnalysisRuleAggregation{
+		AggregateColumns:  expandAggregateColumns(tfMap["aggregate_column"].([]interface{})),
+		DimensionColumns:  flex.ExpandStringValueSet(tfMap["dimension_columns"].(*schema.Set)),
+		JoinColumns:       flex.ExpandStringValueSet(tfMap["join_columns"].(*schema.Set)),
+		OutputConstraints: expandAggregationConstraints(tfMap["output_constraint"].([]interface{})),
+		ScalarFunctions:   flex.ExpandStringyValueSet[types.ScalarFunctions](tfMap["scalar_functions"].(*schema.Set)),
+	}
+
+	if v, ok := tfMap["allowed_join_operators"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedJoinOperators = flex.ExpandStringyValueSet[types.JoinOperator](v)
+	}
+
+	if v, ok := tfMap["join_required"].(string); ok && v != "" {
+		apiObject.JoinRequired = types.JoinRequiredOption(v)
+	}
+
+	return apiObject
+}
+
+func expandAggregateColumns(tfList []interface{}) []types.AggregateColumn {
+	apiObjects := []types.AggregateColumn{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.AggregateColumn{
+			ColumnNames: flex.ExpandStringValueSet(tfMap["column_names"].(*schema.Set)),
+			Function:    types.AggregateFunctionName(tfMap["function"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandAggregationConstraints(tfList []interface{}) []types.AggregationConstraint {
+	apiObjects := []types.AggregationConstraint{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.AggregationConstraint{
+			ColumnName: aws.String(tfMap["column_name"].(string)),
+			Minimum:    aws.Int32(int32(tfMap["minimum"].(int))),
+			Type:       types.AggregationType(tfMap["type"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandAnalysisRuleList(tfMap map[string]interface{}) types.AnalysisRuleList {
+	apiObject := types.AnalysisRuleList{
+		JoinColumns: flex.ExpandStringValueSet(tfMap["join_columns"].(*schema.Set)),
+		ListColumns: flex.ExpandStringValueSet(tfMap["list_columns"].(*schema.Set)),
+	}
+
+	if v, ok := tfMap["allowed_join_operators"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedJoinOperators = flex.ExpandStringyValueSet[types.JoinOperator](v)
+	}
+
+	return apiObject
+}
+
+func flattenConfiguredTableAnalysisRulePolicy(policy types.ConfiguredTableAnalysisRulePolicy) ([]interface{}, []interface{}) {
+	v1, ok := policy.(*types.ConfiguredTableAnalysisRulePolicyMemberV1)
+	if !ok {
+		return nil, nil
+	}
+
+	switch v := v1.Value.(type) {
+	case *types.ConfiguredTableAnalysisRulePolicyV1MemberAggregation:
+		return []interface{}{flattenAnalysisRuleAggregation(v.Value)}, nil
+	case *types.ConfiguredTableAnalysisRulePolicyV1MemberList:
+		return nil, []interface{}{flattenAnalysisRuleList(v.Value)}
+	default:
+		return nil, nil
+	}
+}
+
+func flattenAnalysisRuleAggregation(apiObject types.AnalysisRuleAggregation) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"allowed_join_operators": enum.Slice(apiObject.AllowedJoinOperators...),
+		"dimension_columns":      apiObject.DimensionColumns,
+		"join_columns":           apiObject.JoinColumns,
+		"join_required":          string(apiObject.JoinRequired),
+		"scalar_functions":       enum.Slice(apiObject.ScalarFunctions...),
+	}
+
+	aggregateColumns := []interface{}{}
+	for _, v := range apiObject.AggregateColumns {
+		aggregateColumns = append(aggregateColumns, map[string]interface{}{
+			"column_names": v.ColumnNames,
+			"function":     string(v.Function),
+		})
+	}
+	tfMap["aggregate_column"] = aggregateColumns
+
+	outputConstraints := []interface{}{}
+	for _, v := range apiObject.OutputConstraints {
+		outputConstraints = append(outputConstraints, map[string]interface{}{
+			"column_name": aws.ToString(v.ColumnName),
+			"minimum":     int(aws.ToInt32(v.Minimum)),
+			"type":        string(v.Type),
+		})
+	}
+	tfMap["output_constraint"] = outputConstraints
+
+	return tfMap
+}
+
+func flattenAnalysisRuleList(apiObject types.AnalysisRuleList) map[string]interface{} {
+	return map[string]interface{}{
+		"allowed_join_operators": enum.Slice(apiObject.AllowedJoinOperators...),
+		"join_columns":           apiObject.JoinColumns,
+		"list_columns":           apiObject.ListColumns,
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsMembershipDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cleanrooms_membership.test"
+	resourceName := "aws_cleanrooms_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMembershipDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "collaboration_arn", resourceName, "collaboration_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "collaboration_id", resourceName, "collaboration_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "collaboration_name", resourceName, "collaboration_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "member_abilities.#", resourceName, "member_abilities.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "membership_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "query_log_status", resourceName, "query_log_status"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMembershipDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccMembershipConfig_basic(rName, "DISABLED"), `
+data "aws_cleanrooms_membership" "test" {
+  membership_id = aws_cleanrooms_membership.test.id
+}
+`)
+}
@@ -0,0 +1,719 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/exp/slices"
+)
+
+// genericResource is a Terraform Plugin Framework resource for a CloudFormation resource type
+// whose CRUD operations are performed using the Cloud Control API.
+// Instances are created by the factories generated by internal/generate/cloudcontrol/main.go.
+type genericResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+
+	cfTypeName string // CloudFormation resource type name, e.g. "AWS::Logs::LogGroup"
+	tfTypeName string // Terraform resource type name, e.g. "aws_cloudcontrolapi_logs_log_group"
+	schema     schema.Schema
+	// Terraform attribute names to CloudFormation property names, at all levels of nesting.
+	propertyNames map[string]string
+	// CloudFormation property holding the resource's tags, empty if the resource type isn't taggable.
+	tagsProperty string
+	// Whether the resource's tags are a list of Key/Value objects rather than a map.
+	tagsAsList bool
+	// Top-level attributes whose values are never returned by the Cloud Control API.
+	writeOnlyAttributes []string
+}
+
+func (r *genericResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = r.tfTypeName
+}
+
+func (r *genericResource) Schema(_ context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = r.schema
+}
+
+func (r *genericResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().CloudControlClient(ctx)
+
+	desiredState, err := r.desiredState(ctx, request.Plan.Raw, tagsIn(ctx))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Cloud Control API (%s) Resource", r.cfTypeName), err.Error())
+
+		return
+	}
+
+	input := &cloudcontrol.CreateResourceInput{
+		ClientToken:  aws.String(id.UniqueId()),
+		DesiredState: aws.String(desiredState),
+		TypeName:     aws.String(r.cfTypeName),
+	}
+
+	output, err := conn.CreateResource(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Cloud Control API (%s) Resource", r.cfTypeName), err.Error())
+
+		return
+	}
+
+	var timeouts timeouts.Value
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	progressEvent, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), r.CreateTimeout(ctx, timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Cloud Control API (%s) Resource create", r.cfTypeName), err.Error())
+
+		return
+	}
+
+	identifier := aws.ToString(progressEvent.Identifier)
+	resourceDescription, err := FindResource(ctx, conn, identifier, r.cfTypeName, "", "")
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Cloud Control API (%s) Resource (%s)", r.cfTypeName, identifier), err.Error())
+
+		return
+	}
+
+	state, err := r.state(ctx, identifier, aws.ToString(resourceDescription.Properties), request.Plan.Raw)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Cloud Control API (%s) Resource (%s)", r.cfTypeName, identifier), err.Error())
+
+		return
+	}
+
+	response.State.Raw = state
+}
+
+func (r *genericResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().CloudControlClient(ctx)
+
+	var identifier string
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrID), &identifier)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resourceDescription, err := FindResource(ctx, conn, identifier, r.cfTypeName, "", "")
+
+	if tfresource.NotFound(err) {
+		tflog.Warn(ctx, "Cloud Control API Resource not found, removing from state", map[string]any{
+			"type_name":  r.cfTypeName,
+			"identifier": identifier,
+		})
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Cloud Control API (%s) Resource (%s)", r.cfTypeName, identifier), err.Error())
+
+		return
+	}
+
+	state, err := r.state(ctx, identifier, aws.ToString(resourceDescription.Properties), request.State.Raw)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Cloud Control API (%s) Resource (%s)", r.cfTypeName, identifier), err.Error())
+
+		return
+	}
+
+	response.State.Raw = state
+}
+
+func (r *genericResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	conn := r.Meta().CloudControlClient(ctx)
+
+	var identifier string
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrID), &identifier)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var oldTags tftags.KeyValueTags
+	if r.tagsProperty != "" {
+		var tagsAll basetypes.MapValue
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTagsAll), &tagsAll)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		oldTags = tftags.New(ctx, tagsAll)
+	}
+
+	oldDesiredState, err := r.desiredState(ctx, request.State.Raw, oldTags)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Cloud Control API (%s) Resource (%s)", r.cfTypeName, identifier), err.Error())
+
+		return
+	}
+
+	newDesiredState, err := r.desiredState(ctx, request.Plan.Raw, tagsIn(ctx))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Cloud Control API (%s) Resource (%s)", r.cfTypeName, identifier), err.Error())
+
+		return
+	}
+
+	patchDocument, err := patchDocument(oldDesiredState, newDesiredState)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating JSON Patch", err.Error())
+
+		return
+	}
+
+	if patchDocument != "[]" {
+		input := &cloudcontrol.UpdateResourceInput{
+			ClientToken:   aws.String(id.UniqueId()),
+			Identifier:    aws.String(identifier),
+			PatchDocument: aws.String(patchDocument),
+			TypeName:      aws.String(r.cfTypeName),
+		}
+
+		output, err := conn.UpdateResource(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Cloud Control API (%s) Resource (%s)", r.cfTypeName, identifier), err.Error())
+
+			return
+		}
+
+		var timeouts timeouts.Value
+		response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if _, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), r.UpdateTimeout(ctx, timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Cloud Control API (%s) Resource (%s) update", r.cfTypeName, identifier), err.Error())
+
+			return
+		}
+	}
+
+	resourceDescription, err := FindResource(ctx, conn, identifier, r.cfTypeName, "", "")
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Cloud Control API (%s) Resource (%s)", r.cfTypeName, identifier), err.Error())
+
+		return
+	}
+
+	state, err := r.state(ctx, identifier, aws.ToString(resourceDescription.Properties), request.Plan.Raw)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Cloud Control API (%s) Resource (%s)", r.cfTypeName, identifier), err.Error())
+
+		return
+	}
+
+	response.State.Raw = state
+}
+
+func (r *genericResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().CloudControlClient(ctx)
+
+	var identifier string
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrID), &identifier)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting Cloud Control API Resource", map[string]any{
+		"type_name":  r.cfTypeName,
+		"identifier": identifier,
+	})
+	output, err := conn.DeleteResource(ctx, &cloudcontrol.DeleteResourceInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Identifier:  aws.String(identifier),
+		TypeName:    aws.String(r.cfTypeName),
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Cloud Control API (%s) Resource (%s)", r.cfTypeName, identifier), err.Error())
+
+		return
+	}
+
+	var timeouts timeouts.Value
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	progressEvent, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), r.DeleteTimeout(ctx, timeouts))
+
+	if progressEvent != nil && progressEvent.ErrorCode == awstypes.HandlerErrorCodeNotFound {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Cloud Control API (%s) Resource (%s) delete", r.cfTypeName, identifier), err.Error())
+
+		return
+	}
+}
+
+func (r *genericResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if r.tagsProperty != "" {
+		r.SetTagsAll(ctx, request, response)
+	}
+}
+
+// desiredState returns the CloudFormation resource model, as JSON, corresponding to the specified plan or state.
+// Null, unknown and read-only values are omitted.
+func (r *genericResource) desiredState(ctx context.Context, v tftypes.Value, tags tftags.KeyValueTags) (string, error) {
+	var values map[string]tftypes.Value
+
+	if err := v.As(&values); err != nil {
+		return "", err
+	}
+
+	model := make(map[string]any)
+
+	for name, attribute := range r.schema.Attributes {
+		switch name {
+		case names.AttrID, names.AttrTags, names.AttrTagsAll:
+			continue
+		}
+
+		if attribute.IsComputed() && !attribute.IsOptional() {
+			continue
+		}
+
+		value, ok, err := r.expand(ctx, attribute.GetType(), values[name])
+
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", name, err)
+		}
+
+		if ok {
+			model[r.propertyNames[name]] = value
+		}
+	}
+
+	if r.tagsProperty != "" && len(tags) > 0 {
+		model[r.tagsProperty] = r.expandTags(tags)
+	}
+
+	b, err := json.Marshal(model)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// state returns the resource's Terraform state corresponding to the specified CloudFormation resource model.
+// Values not returned by the Cloud Control API are taken from the prior plan or state.
+func (r *genericResource) state(ctx context.Context, identifier, properties string, prior tftypes.Value) (tftypes.Value, error) {
+	var model map[string]any
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(properties)))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&model); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	var priorValues map[string]tftypes.Value
+
+	if err := prior.As(&priorValues); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	values := make(map[string]tftypes.Value, len(priorValues))
+
+	for name, value := range priorValues {
+		values[name] = value
+	}
+
+	values[names.AttrID] = tftypes.NewValue(tftypes.String, identifier)
+
+	for name, attribute := range r.schema.Attributes {
+		switch name {
+		case names.AttrID, names.AttrTags, names.AttrTagsAll:
+			continue
+		}
+
+		// Write-only values are never returned.
+		if slices.Contains(r.writeOnlyAttributes, name) {
+			if !values[name].IsKnown() {
+				values[name] = tftypes.NewValue(attribute.GetType().TerraformType(ctx), nil)
+			}
+
+			continue
+		}
+
+		value, err := r.flatten(ctx, attribute.GetType(), model[r.propertyNames[name]])
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("%s: %w", name, err)
+		}
+
+		values[name] = value
+	}
+
+	if r.tagsProperty != "" {
+		if inContext, ok := tftags.FromContext(ctx); ok {
+			inContext.TagsOut = types.Some(r.flattenTags(ctx, model[r.tagsProperty]))
+		}
+	}
+
+	return tftypes.NewValue(prior.Type(), values), nil
+}
+
+// expand returns the JSON value corresponding to the specified Terraform value.
+// Null and unknown values return false.
+func (r *genericResource) expand(ctx context.Context, t attr.Type, v tftypes.Value) (any, bool, error) {
+	if v.IsNull() || !v.IsKnown() {
+		return nil, false, nil
+	}
+
+	if t.Equal(fwtypes.JSONType) {
+		var s string
+
+		if err := v.As(&s); err != nil {
+			return nil, false, err
+		}
+
+		var value any
+
+		if err := json.Unmarshal([]byte(s), &value); err != nil {
+			return nil, false, err
+		}
+
+		return value, true, nil
+	}
+
+	switch t := t.(type) {
+	case basetypes.StringType:
+		var s string
+
+		if err := v.As(&s); err != nil {
+			return nil, false, err
+		}
+
+		return s, true, nil
+
+	case basetypes.BoolType:
+		var b bool
+
+		if err := v.As(&b); err != nil {
+			return nil, false, err
+		}
+
+		return b, true, nil
+
+	case basetypes.Int64Type, basetypes.Float64Type:
+		f := new(big.Float)
+
+		if err := v.As(&f); err != nil {
+			return nil, false, err
+		}
+
+		if f.IsInt() {
+			return json.Number(f.Text('f', 0)), true, nil
+		}
+
+		return json.Number(f.Text('g', -1)), true, nil
+
+	case basetypes.ListType:
+		return r.expandElements(ctx, t.ElemType, v)
+
+	case basetypes.SetType:
+		return r.expandElements(ctx, t.ElemType, v)
+
+	case basetypes.MapType:
+		var elements map[string]tftypes.Value
+
+		if err := v.As(&elements); err != nil {
+			return nil, false, err
+		}
+
+		value := make(map[string]any, len(elements))
+
+		for k, v := range elements {
+			element, ok, err := r.expand(ctx, t.ElemType, v)
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			if ok {
+				value[k] = element
+			}
+		}
+
+		return value, true, nil
+
+	case basetypes.ObjectType:
+		var attributes map[string]tftypes.Value
+
+		if err := v.As(&attributes); err != nil {
+			return nil, false, err
+		}
+
+		value := make(map[string]any, len(attributes))
+
+		for name, attrType := range t.AttrTypes {
+			attribute, ok, err := r.expand(ctx, attrType, attributes[name])
+
+			if err != nil {
+				return nil, false, fmt.Errorf("%s: %w", name, err)
+			}
+
+			if ok {
+				value[r.propertyNames[name]] = attribute
+			}
+		}
+
+		return value, true, nil
+	}
+
+	return nil, false, fmt.Errorf("unsupported type: %s", t)
+}
+
+func (r *genericResource) expandElements(ctx context.Context, t attr.Type, v tftypes.Value) (any, bool, error) {
+	var elements []tftypes.Value
+
+	if err := v.As(&elements); err != nil {
+		return nil, false, err
+	}
+
+	value := make([]any, 0, len(elements))
+
+	for _, v := range elements {
+		element, ok, err := r.expand(ctx, t, v)
+
+		if err != nil {
+			return nil, false, err
+		}
+
+		if ok {
+			value = append(value, element)
+		}
+	}
+
+	return value, true, nil
+}
+
+// flatten returns the Terraform value of the specified type corresponding to the specified JSON value.
+func (r *genericResource) flatten(ctx context.Context, t attr.Type, v any) (tftypes.Value, error) {
+	tfType := t.TerraformType(ctx)
+
+	if v == nil {
+		return tftypes.NewValue(tfType, nil), nil
+	}
+
+	if t.Equal(fwtypes.JSONType) {
+		b, err := json.Marshal(v)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		return tftypes.NewValue(tfType, string(b)), nil
+	}
+
+	switch t := t.(type) {
+	case basetypes.StringType:
+		switch v := v.(type) {
+		case string:
+			return tftypes.NewValue(tfType, v), nil
+		case json.Number:
+			return tftypes.NewValue(tfType, v.String()), nil
+		case bool:
+			return tftypes.NewValue(tfType, fmt.Sprint(v)), nil
+		}
+
+	case basetypes.BoolType:
+		if v, ok := v.(bool); ok {
+			return tftypes.NewValue(tfType, v), nil
+		}
+
+	case basetypes.Int64Type, basetypes.Float64Type:
+		if v, ok := v.(json.Number); ok {
+			f, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			return tftypes.NewValue(tfType, f), nil
+		}
+
+	case basetypes.ListType:
+		return r.flattenElements(ctx, tfType, t.ElemType, v)
+
+	case basetypes.SetType:
+		return r.flattenElements(ctx, tfType, t.ElemType, v)
+
+	case basetypes.MapType:
+		if v, ok := v.(map[string]any); ok {
+			elements := make(map[string]tftypes.Value, len(v))
+
+			for k, v := range v {
+				element, err := r.flatten(ctx, t.ElemType, v)
+
+				if err != nil {
+					return tftypes.Value{}, err
+				}
+
+				elements[k] = element
+			}
+
+			return tftypes.NewValue(tfType, elements), nil
+		}
+
+	case basetypes.ObjectType:
+		if v, ok := v.(map[string]any); ok {
+			attributes := make(map[string]tftypes.Value, len(t.AttrTypes))
+
+			for name, attrType := range t.AttrTypes {
+				attribute, err := r.flatten(ctx, attrType, v[r.propertyNames[name]])
+
+				if err != nil {
+					return tftypes.Value{}, fmt.Errorf("%s: %w", name, err)
+				}
+
+				attributes[name] = attribute
+			}
+
+			return tftypes.NewValue(tfType, attributes), nil
+		}
+
+	default:
+		return tftypes.Value{}, fmt.Errorf("unsupported type: %s", t)
+	}
+
+	return tftypes.Value{}, fmt.Errorf("unexpected %T value for %s", v, t)
+}
+
+func (r *genericResource) flattenElements(ctx context.Context, tfType tftypes.Type, t attr.Type, v any) (tftypes.Value, error) {
+	values, ok := v.([]any)
+
+	if !ok {
+		return tftypes.Value{}, fmt.Errorf("unexpected %T value for %s", v, tfType)
+	}
+
+	elements := make([]tftypes.Value, 0, len(values))
+
+	for _, v := range values {
+		element, err := r.flatten(ctx, t, v)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		elements = append(elements, element)
+	}
+
+	return tftypes.NewValue(tfType, elements), nil
+}
+
+// expandTags returns the CloudFormation representation of the specified tags.
+func (r *genericResource) expandTags(tags tftags.KeyValueTags) any {
+	m := tags.Map()
+
+	if !r.tagsAsList {
+		return m
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	list := make([]any, 0, len(keys))
+	for _, k := range keys {
+		list = append(list, map[string]any{
+			"Key":   k,
+			"Value": m[k],
+		})
+	}
+
+	return list
+}
+
+// flattenTags returns the tags corresponding to the specified CloudFormation representation.
+func (r *genericResource) flattenTags(ctx context.Context, v any) tftags.KeyValueTags {
+	m := make(map[string]string)
+
+	switch v := v.(type) {
+	case []any:
+		for _, v := range v {
+			if v, ok := v.(map[string]any); ok {
+				key, _ := v["Key"].(string)
+				value, _ := v["Value"].(string)
+				m[key] = value
+			}
+		}
+	case map[string]any:
+		for k, v := range v {
+			if v, ok := v.(string); ok {
+				m[k] = v
+			}
+		}
+	}
+
+	return tftags.New(ctx, m)
+}
+
+// tagsIn returns the resource's configured tags, including any provider default tags.
+func tagsIn(ctx context.Context) tftags.KeyValueTags {
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		return inContext.TagsIn.UnwrapOrDefault()
+	}
+
+	return nil
+}
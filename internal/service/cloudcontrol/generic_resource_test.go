@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestGenericResourceDesiredState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := testGenericResource(ctx, t)
+	typ := r.schema.Type().TerraformType(ctx)
+
+	values := nullValues(ctx, t, r)
+	values["arn"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	values["data_protection_policy"] = tftypes.NewValue(tftypes.String, `{"Name":"policy","Version":"2021-06-01"}`)
+	values["log_group_name"] = tftypes.NewValue(tftypes.String, "test")
+	values["retention_in_days"] = tftypes.NewValue(tftypes.Number, big.NewFloat(7))
+
+	got, err := r.desiredState(ctx, tftypes.NewValue(typ, values), tftags.New(ctx, map[string]string{"Name": "test", "Env": "dev"}))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{
+  "DataProtectionPolicy": {"Name": "policy", "Version": "2021-06-01"},
+  "LogGroupName": "test",
+  "RetentionInDays": 7,
+  "Tags": [{"Key": "Env", "Value": "dev"}, {"Key": "Name", "Value": "test"}]
+}`
+
+	if !jsonEqual(t, got, expected) {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+func TestGenericResourceState(t *testing.T) {
+	t.Parallel()
+
+	ctx := tftags.NewContext(context.Background(), nil, nil)
+	r := testGenericResource(ctx, t)
+	typ := r.schema.Type().TerraformType(ctx)
+
+	prior := nullValues(ctx, t, r)
+	prior["arn"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	prior["log_group_name"] = tftypes.NewValue(tftypes.String, "test")
+
+	properties := `{
+  "Arn": "arn:aws:logs:us-west-2:123456789012:log-group:test:*",
+  "LogGroupClass": "STANDARD",
+  "LogGroupName": "test",
+  "RetentionInDays": 7,
+  "Tags": [{"Key": "Name", "Value": "test"}]
+}`
+
+	got, err := r.state(ctx, "test", properties, tftypes.NewValue(typ, prior))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var values map[string]tftypes.Value
+	if err := got.As(&values); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for name, expected := range map[string]tftypes.Value{
+		"arn":                    tftypes.NewValue(tftypes.String, "arn:aws:logs:us-west-2:123456789012:log-group:test:*"),
+		"data_protection_policy": tftypes.NewValue(tftypes.String, nil),
+		"id":                     tftypes.NewValue(tftypes.String, "test"),
+		"kms_key_id":             tftypes.NewValue(tftypes.String, nil),
+		"log_group_class":        tftypes.NewValue(tftypes.String, "STANDARD"),
+		"retention_in_days":      tftypes.NewValue(tftypes.Number, big.NewFloat(7)),
+	} {
+		if got := values[name]; !got.Equal(expected) {
+			t.Errorf("%s: got %s, expected %s", name, got, expected)
+		}
+	}
+
+	inContext, _ := tftags.FromContext(ctx)
+	if got, expected := inContext.TagsOut.UnwrapOrDefault().Map(), map[string]string{"Name": "test"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got tags %v, expected %v", got, expected)
+	}
+}
+
+func testGenericResource(ctx context.Context, t *testing.T) *genericResource {
+	t.Helper()
+
+	v, err := newResourceLogsLogGroup(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return v.(*genericResource)
+}
+
+// nullValues returns null values for all of the resource's attributes and blocks.
+func nullValues(ctx context.Context, t *testing.T, r *genericResource) map[string]tftypes.Value {
+	t.Helper()
+
+	values := make(map[string]tftypes.Value)
+
+	for name, v := range r.schema.Type().TerraformType(ctx).(tftypes.Object).AttributeTypes {
+		values[name] = tftypes.NewValue(v, nil)
+	}
+
+	return values
+}
+
+func jsonEqual(t *testing.T, a, b string) bool {
+	t.Helper()
+
+	var x, y any
+
+	if err := json.Unmarshal([]byte(a), &x); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := json.Unmarshal([]byte(b), &y); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return reflect.DeepEqual(x, y)
+}
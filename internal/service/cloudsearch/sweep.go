@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudsearch_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudsearch_domain", &resource.Sweeper{
 		Name: "aws_cloudsearch_domain",
 		F:    sweepDomains,
 	})
@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cur
+
+// IsGlobal returns true as Cost and Usage Report resources are not Regional.
+func (p *servicePackage) IsGlobal() bool {
+	return true
+}
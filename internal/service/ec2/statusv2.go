@@ -60,3 +60,23 @@ func statusVPCAttributeValueV2(ctx context.Context, conn *ec2.Client, id string,
 		return attributeValue, strconv.FormatBool(attributeValue), nil
 	}
 }
+
+func statusVerifiedAccessEndpoint(ctx context.Context, conn *ec2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindVerifiedAccessEndpointByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.Status == nil {
+			return output, "", nil
+		}
+
+		return output, string(output.Status.Code), nil
+	}
+}
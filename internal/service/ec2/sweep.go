@@ -7,6 +7,7 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -18,12 +19,15 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_customer_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_customer_gateway", &resource.Sweeper{
 		Name: "aws_customer_gateway",
 		F:    sweepCustomerGateways,
 		Dependencies: []string{
@@ -31,17 +35,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_capacity_reservation", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_capacity_reservation", &resource.Sweeper{
 		Name: "aws_ec2_capacity_reservation",
 		F:    sweepCapacityReservations,
 	})
 
-	resource.AddTestSweepers("aws_ec2_carrier_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_carrier_gateway", &resource.Sweeper{
 		Name: "aws_ec2_carrier_gateway",
 		F:    sweepCarrierGateways,
 	})
 
-	resource.AddTestSweepers("aws_ec2_client_vpn_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_client_vpn_endpoint", &resource.Sweeper{
 		Name: "aws_ec2_client_vpn_endpoint",
 		F:    sweepClientVPNEndpoints,
 		Dependencies: []string{
@@ -49,38 +53,27 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_client_vpn_network_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_client_vpn_network_association", &resource.Sweeper{
 		Name: "aws_ec2_client_vpn_network_association",
 		F:    sweepClientVPNNetworkAssociations,
 	})
 
-	resource.AddTestSweepers("aws_ec2_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_fleet", &resource.Sweeper{
 		Name: "aws_ec2_fleet",
 		F:    sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_ebs_volume", &resource.Sweeper{
-		Name: "aws_ebs_volume",
-		Dependencies: []string{
-			"aws_instance",
-		},
-		F: sweepEBSVolumes,
-	})
+	sweep.Register("aws_ebs_volume", sweepEBSVolumes,
+		"aws_instance",
+	)
 
-	resource.AddTestSweepers("aws_ebs_snapshot", &resource.Sweeper{
-		Name: "aws_ebs_snapshot",
-		F:    sweepEBSSnapshots,
-		Dependencies: []string{
-			"aws_ami",
-		},
-	})
+	sweep.Register("aws_ebs_snapshot", sweepEBSSnapshots,
+		"aws_ami",
+	)
 
-	resource.AddTestSweepers("aws_egress_only_internet_gateway", &resource.Sweeper{
-		Name: "aws_egress_only_internet_gateway",
-		F:    sweepEgressOnlyInternetGateways,
-	})
+	sweep.Register("aws_egress_only_internet_gateway", sweepEgressOnlyInternetGateways)
 
-	resource.AddTestSweepers("aws_eip", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eip", &resource.Sweeper{
 		Name: "aws_eip",
 		Dependencies: []string{
 			"aws_vpc",
@@ -88,20 +81,13 @@ func init() {
 		F: sweepEIPs,
 	})
 
-	resource.AddTestSweepers("aws_flow_log", &resource.Sweeper{
-		Name: "aws_flow_log",
-		F:    sweepFlowLogs,
-	})
+	sweep.Register("aws_flow_log", sweepFlowLogs)
 
-	resource.AddTestSweepers("aws_ec2_host", &resource.Sweeper{
-		Name: "aws_ec2_host",
-		F:    sweepHosts,
-		Dependencies: []string{
-			"aws_instance",
-		},
-	})
+	sweep.Register("aws_ec2_host", sweepHosts,
+		"aws_instance",
+	)
 
-	resource.AddTestSweepers("aws_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_instance", &resource.Sweeper{
 		Name: "aws_instance",
 		F:    sweepInstances,
 		Dependencies: []string{
@@ -111,45 +97,27 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_internet_gateway", &resource.Sweeper{
-		Name: "aws_internet_gateway",
-		Dependencies: []string{
-			"aws_subnet",
-		},
-		F: sweepInternetGateways,
-	})
+	sweep.Register("aws_internet_gateway", sweepInternetGateways,
+		"aws_subnet",
+	)
 
-	resource.AddTestSweepers("aws_key_pair", &resource.Sweeper{
-		Name: "aws_key_pair",
-		Dependencies: []string{
-			"aws_elastic_beanstalk_environment",
-			"aws_instance",
-			"aws_spot_fleet_request",
-			"aws_spot_instance_request",
-		},
-		F: sweepKeyPairs,
-	})
+	sweep.Register("aws_key_pair", sweepKeyPairs,
+		"aws_elastic_beanstalk_environment",
+		"aws_instance",
+		"aws_spot_fleet_request",
+		"aws_spot_instance_request",
+	)
 
-	resource.AddTestSweepers("aws_launch_template", &resource.Sweeper{
-		Name: "aws_launch_template",
-		Dependencies: []string{
-			"aws_autoscaling_group",
-			"aws_batch_compute_environment",
-		},
-		F: sweepLaunchTemplates,
-	})
+	sweep.Register("aws_launch_template", sweepLaunchTemplates,
+		"aws_autoscaling_group",
+		"aws_batch_compute_environment",
+	)
 
-	resource.AddTestSweepers("aws_nat_gateway", &resource.Sweeper{
-		Name: "aws_nat_gateway",
-		F:    sweepNATGateways,
-	})
+	sweep.Register("aws_nat_gateway", sweepNATGateways)
 
-	resource.AddTestSweepers("aws_network_acl", &resource.Sweeper{
-		Name: "aws_network_acl",
-		F:    sweepNetworkACLs,
-	})
+	sweep.Register("aws_network_acl", sweepNetworkACLs)
 
-	resource.AddTestSweepers("aws_network_interface", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_network_interface", &resource.Sweeper{
 		Name: "aws_network_interface",
 		F:    sweepNetworkInterfaces,
 		Dependencies: []string{
@@ -168,12 +136,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_network_insights_path", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_network_insights_path", &resource.Sweeper{
 		Name: "aws_ec2_network_insights_path",
 		F:    sweepNetworkInsightsPaths,
 	})
 
-	resource.AddTestSweepers("aws_placement_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_placement_group", &resource.Sweeper{
 		Name: "aws_placement_group",
 		F:    sweepPlacementGroups,
 		Dependencies: []string{
@@ -185,12 +153,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route_table", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route_table", &resource.Sweeper{
 		Name: "aws_route_table",
 		F:    sweepRouteTables,
 	})
 
-	resource.AddTestSweepers("aws_security_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_security_group", &resource.Sweeper{
 		Name: "aws_security_group",
 		Dependencies: []string{
 			"aws_subnet",
@@ -198,69 +166,65 @@ func init() {
 		F: sweepSecurityGroups,
 	})
 
-	resource.AddTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
 		Name: "aws_spot_fleet_request",
 		F:    sweepSpotFleetRequests,
 	})
 
-	resource.AddTestSweepers("aws_spot_instance_request", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_spot_instance_request", &resource.Sweeper{
 		Name: "aws_spot_instance_request",
 		F:    sweepSpotInstanceRequests,
 	})
 
-	resource.AddTestSweepers("aws_subnet", &resource.Sweeper{
-		Name: "aws_subnet",
-		F:    sweepSubnets,
-		Dependencies: []string{
-			"aws_appstream_fleet",
-			"aws_appstream_image_builder",
-			"aws_autoscaling_group",
-			"aws_batch_compute_environment",
-			"aws_elastic_beanstalk_environment",
-			"aws_cloud9_environment_ec2",
-			"aws_cloudhsm_v2_cluster",
-			"aws_codestarconnections_host",
-			"aws_db_subnet_group",
-			"aws_directory_service_directory",
-			"aws_dms_replication_instance",
-			"aws_docdb_subnet_group",
-			"aws_ec2_client_vpn_endpoint",
-			"aws_ec2_instance_connect_endpoint",
-			"aws_ec2_transit_gateway_vpc_attachment",
-			"aws_efs_file_system",
-			"aws_eks_cluster",
-			"aws_elasticache_cluster",
-			"aws_elasticache_replication_group",
-			"aws_elasticache_subnet_group",
-			"aws_elasticsearch_domain",
-			"aws_elb",
-			"aws_emr_cluster",
-			"aws_emr_studio",
-			"aws_fsx_lustre_file_system",
-			"aws_fsx_ontap_file_system",
-			"aws_fsx_openzfs_file_system",
-			"aws_fsx_windows_file_system",
-			"aws_iot_topic_rule_destination",
-			"aws_lambda_function",
-			"aws_lb",
-			"aws_memorydb_subnet_group",
-			"aws_mq_broker",
-			"aws_msk_cluster",
-			"aws_network_interface",
-			"aws_networkfirewall_firewall",
-			"aws_opensearch_domain",
-			"aws_redshift_cluster",
-			"aws_redshift_subnet_group",
-			"aws_route53_resolver_endpoint",
-			"aws_sagemaker_notebook_instance",
-			"aws_spot_fleet_request",
-			"aws_spot_instance_request",
-			"aws_vpc_endpoint",
-			"aws_grafana_workspace",
-		},
-	})
-
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_filter", &resource.Sweeper{
+	sweep.Register("aws_subnet", sweepSubnets,
+		"aws_appstream_fleet",
+		"aws_appstream_image_builder",
+		"aws_autoscaling_group",
+		"aws_batch_compute_environment",
+		"aws_elastic_beanstalk_environment",
+		"aws_cloud9_environment_ec2",
+		"aws_cloudhsm_v2_cluster",
+		"aws_codestarconnections_host",
+		"aws_db_subnet_group",
+		"aws_directory_service_directory",
+		"aws_dms_replication_instance",
+		"aws_docdb_subnet_group",
+		"aws_ec2_client_vpn_endpoint",
+		"aws_ec2_instance_connect_endpoint",
+		"aws_ec2_transit_gateway_vpc_attachment",
+		"aws_efs_file_system",
+		"aws_eks_cluster",
+		"aws_elasticache_cluster",
+		"aws_elasticache_replication_group",
+		"aws_elasticache_subnet_group",
+		"aws_elasticsearch_domain",
+		"aws_elb",
+		"aws_emr_cluster",
+		"aws_emr_studio",
+		"aws_fsx_lustre_file_system",
+		"aws_fsx_ontap_file_system",
+		"aws_fsx_openzfs_file_system",
+		"aws_fsx_windows_file_system",
+		"aws_iot_topic_rule_destination",
+		"aws_lambda_function",
+		"aws_lb",
+		"aws_memorydb_subnet_group",
+		"aws_mq_broker",
+		"aws_msk_cluster",
+		"aws_network_interface",
+		"aws_networkfirewall_firewall",
+		"aws_opensearch_domain",
+		"aws_redshift_cluster",
+		"aws_redshift_subnet_group",
+		"aws_route53_resolver_endpoint",
+		"aws_sagemaker_notebook_instance",
+		"aws_spot_fleet_request",
+		"aws_spot_instance_request",
+		"aws_vpc_endpoint",
+		"aws_grafana_workspace",
+	)
+
+	sweep.AddTestSweepers("aws_ec2_traffic_mirror_filter", &resource.Sweeper{
 		Name: "aws_ec2_traffic_mirror_filter",
 		F:    sweepTrafficMirrorFilters,
 		Dependencies: []string{
@@ -268,12 +232,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_session", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_traffic_mirror_session", &resource.Sweeper{
 		Name: "aws_ec2_traffic_mirror_session",
 		F:    sweepTrafficMirrorSessions,
 	})
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_target", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_traffic_mirror_target", &resource.Sweeper{
 		Name: "aws_ec2_traffic_mirror_target",
 		F:    sweepTrafficMirrorTargets,
 		Dependencies: []string{
@@ -281,17 +245,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_peering_attachment",
 		F:    sweepTransitGatewayPeeringAttachments,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_multicast_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_multicast_domain", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_multicast_domain",
 		F:    sweepTransitGatewayMulticastDomains,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway",
 		F:    sweepTransitGateways,
 		Dependencies: []string{
@@ -302,12 +266,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_connect_peer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_connect_peer", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_connect_peer",
 		F:    sweepTransitGatewayConnectPeers,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_connect", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_connect", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_connect",
 		F:    sweepTransitGatewayConnects,
 		Dependencies: []string{
@@ -315,7 +279,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_vpc_attachment",
 		F:    sweepTransitGatewayVPCAttachments,
 		Dependencies: []string{
@@ -324,12 +288,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_dhcp_options", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_dhcp_options", &resource.Sweeper{
 		Name: "aws_vpc_dhcp_options",
 		F:    sweepVPCDHCPOptions,
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
 		Name: "aws_vpc_endpoint_service",
 		F:    sweepVPCEndpointServices,
 		Dependencies: []string{
@@ -337,7 +301,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint", &resource.Sweeper{
 		Name: "aws_vpc_endpoint",
 		F:    sweepVPCEndpoints,
 		Dependencies: []string{
@@ -346,34 +310,30 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_peering_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_peering_connection", &resource.Sweeper{
 		Name: "aws_vpc_peering_connection",
 		F:    sweepVPCPeeringConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpc", &resource.Sweeper{
-		Name: "aws_vpc",
-		Dependencies: []string{
-			"aws_ec2_carrier_gateway",
-			"aws_egress_only_internet_gateway",
-			"aws_internet_gateway",
-			"aws_nat_gateway",
-			"aws_network_acl",
-			"aws_route_table",
-			"aws_security_group",
-			"aws_subnet",
-			"aws_vpc_peering_connection",
-			"aws_vpn_gateway",
-		},
-		F: sweepVPCs,
-	})
-
-	resource.AddTestSweepers("aws_vpn_connection", &resource.Sweeper{
+	sweep.Register("aws_vpc", sweepVPCs,
+		"aws_ec2_carrier_gateway",
+		"aws_egress_only_internet_gateway",
+		"aws_internet_gateway",
+		"aws_nat_gateway",
+		"aws_network_acl",
+		"aws_route_table",
+		"aws_security_group",
+		"aws_subnet",
+		"aws_vpc_peering_connection",
+		"aws_vpn_gateway",
+	)
+
+	sweep.AddTestSweepers("aws_vpn_connection", &resource.Sweeper{
 		Name: "aws_vpn_connection",
 		F:    sweepVPNConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpn_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpn_gateway", &resource.Sweeper{
 		Name: "aws_vpn_gateway",
 		F:    sweepVPNGateways,
 		Dependencies: []string{
@@ -382,30 +342,58 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_ipam", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_ipam", &resource.Sweeper{
 		Name: "aws_vpc_ipam",
 		F:    sweepIPAMs,
 	})
 
-	resource.AddTestSweepers("aws_vpc_ipam_resource_discovery", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_ipam_resource_discovery", &resource.Sweeper{
 		Name: "aws_vpc_ipam_resource_discovery",
 		F:    sweepIPAMResourceDiscoveries,
 	})
 
-	resource.AddTestSweepers("aws_ami", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ami", &resource.Sweeper{
 		Name: "aws_ami",
 		F:    sweepAMIs,
 	})
 
-	resource.AddTestSweepers("aws_vpc_network_performance_metric_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_network_performance_metric_subscription", &resource.Sweeper{
 		Name: "aws_vpc_network_performance_metric_subscription",
 		F:    sweepNetworkPerformanceMetricSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_ec2_instance_connect_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_instance_connect_endpoint", &resource.Sweeper{
 		Name: "aws_ec2_instance_connect_endpoint",
 		F:    sweepInstanceConnectEndpoints,
 	})
+
+	sweep.Register("aws_verifiedaccess_endpoint", sweepVerifiedAccessEndpoints)
+
+	sweep.Register("aws_verifiedaccess_group", sweepVerifiedAccessGroups,
+		"aws_verifiedaccess_endpoint",
+	)
+
+	sweep.Register("aws_verifiedaccess_instance", sweepVerifiedAccessInstances,
+		"aws_verifiedaccess_group",
+		"aws_verifiedaccess_instance_trust_provider_attachment",
+	)
+
+	sweep.Register("aws_verifiedaccess_instance_trust_provider_attachment", sweepVerifiedAccessInstanceTrustProviderAttachments,
+		"aws_verifiedaccess_group",
+	)
+
+	sweep.Register("aws_verifiedaccess_trust_provider", sweepVerifiedAccessTrustProviders,
+		"aws_verifiedaccess_instance_trust_provider_attachment",
+	)
+
+	// Import IDs of resources not imported using schema.ImportStatePassthroughContext.
+	sweep.RegisterImportID("aws_ec2_client_vpn_network_association", func(get filter.AttributeGetter) string {
+		return fmt.Sprintf("%s,%s", get("client_vpn_endpoint_id"), get(names.AttrID))
+	})
+	sweep.RegisterImportID("aws_ec2_instance_connect_endpoint", sweep.ImportIDAttribute(names.AttrID))
+	sweep.RegisterImportID("aws_network_acl", sweep.ImportIDAttribute(names.AttrID))
+	sweep.RegisterImportID("aws_spot_fleet_request", sweep.ImportIDAttribute(names.AttrID))
+	sweep.RegisterImportID("aws_vpc", sweep.ImportIDAttribute(names.AttrID))
 }
 
 func sweepCapacityReservations(region string) error {
@@ -656,17 +644,12 @@ func sweepFleets(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepEBSVolumes(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func sweepEBSVolumes(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.EC2Conn(ctx)
 	input := &ec2.DescribeVolumesInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.DescribeVolumesPagesWithContext(ctx, input, func(page *ec2.DescribeVolumesOutput, lastPage bool) bool {
+	err := conn.DescribeVolumesPagesWithContext(ctx, input, func(page *ec2.DescribeVolumesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -689,37 +672,21 @@ func sweepEBSVolumes(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping EC2 EBS Volume sweep for %s: %s", region, err)
-		return nil
-	}
-
 	if err != nil {
-		return fmt.Errorf("error listing EC2 EBS Volumes (%s): %w", region, err)
+		return nil, err
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping EC2 EBS Volumes (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
-func sweepEBSSnapshots(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func sweepEBSSnapshots(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	input := &ec2.DescribeSnapshotsInput{
 		OwnerIds: aws.StringSlice([]string{"self"}),
 	}
 	conn := client.EC2Conn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.DescribeSnapshotsPagesWithContext(ctx, input, func(page *ec2.DescribeSnapshotsOutput, lastPage bool) bool {
+	err := conn.DescribeSnapshotsPagesWithContext(ctx, input, func(page *ec2.DescribeSnapshotsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -735,35 +702,19 @@ func sweepEBSSnapshots(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping EBS Snapshot sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("error listing EBS Snapshots (%s): %w", region, err)
-	}
-
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
 	if err != nil {
-		return fmt.Errorf("error sweeping EBS Snapshots (%s): %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
 
-func sweepEgressOnlyInternetGateways(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func sweepEgressOnlyInternetGateways(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	input := &ec2.DescribeEgressOnlyInternetGatewaysInput{}
 	conn := client.EC2Conn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.DescribeEgressOnlyInternetGatewaysPagesWithContext(ctx, input, func(page *ec2.DescribeEgressOnlyInternetGatewaysOutput, lastPage bool) bool {
+	err := conn.DescribeEgressOnlyInternetGatewaysPagesWithContext(ctx, input, func(page *ec2.DescribeEgressOnlyInternetGatewaysOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -779,22 +730,11 @@ func sweepEgressOnlyInternetGateways(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping EC2 Egress-only Internet Gateway sweep for %s: %s", region, err)
-		return nil
-	}
-
 	if err != nil {
-		return fmt.Errorf("error listing EC2 Egress-only Internet Gateways (%s): %w", region, err)
+		return nil, err
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping EC2 Egress-only Internet Gateways (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func sweepEIPs(region string) error {
@@ -860,17 +800,12 @@ func sweepEIPs(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepFlowLogs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func sweepFlowLogs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.EC2Conn(ctx)
 	input := &ec2.DescribeFlowLogsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.DescribeFlowLogsPagesWithContext(ctx, input, func(page *ec2.DescribeFlowLogsOutput, lastPage bool) bool {
+	err := conn.DescribeFlowLogsPagesWithContext(ctx, input, func(page *ec2.DescribeFlowLogsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -886,35 +821,19 @@ func sweepFlowLogs(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Flow Log sweep for %s: %s", region, err)
-		return nil
-	}
-
 	if err != nil {
-		return fmt.Errorf("error listing Flow Logs (%s): %w", region, err)
+		return nil, err
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping Flow Logs (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
-func sweepHosts(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func sweepHosts(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.EC2Conn(ctx)
 	input := &ec2.DescribeHostsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.DescribeHostsPagesWithContext(ctx, input, func(page *ec2.DescribeHostsOutput, lastPage bool) bool {
+	err := conn.DescribeHostsPagesWithContext(ctx, input, func(page *ec2.DescribeHostsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -930,22 +849,11 @@ func sweepHosts(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping EC2 Host sweep for %s: %s", region, err)
-		return nil
-	}
-
 	if err != nil {
-		return fmt.Errorf("error listing EC2 Hosts (%s): %w", region, err)
+		return nil, err
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping EC2 Hosts (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func sweepInstances(region string) error {
@@ -1004,12 +912,7 @@ func sweepInstances(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepInternetGateways(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func sweepInternetGateways(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.EC2Conn(ctx)
 
 	defaultVPCID := ""
@@ -1025,7 +928,7 @@ func sweepInternetGateways(region string) error {
 	describeVpcsOutput, err := conn.DescribeVpcsWithContext(ctx, describeVpcsInput)
 
 	if err != nil {
-		return fmt.Errorf("error describing VPCs: %w", err)
+		return nil, err
 	}
 
 	if describeVpcsOutput != nil && len(describeVpcsOutput.Vpcs) == 1 {
@@ -1069,43 +972,22 @@ func sweepInternetGateways(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping EC2 Internet Gateway sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("error listing EC2 Internet Gateways (%s): %w", region, err)
-	}
-
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
 	if err != nil {
-		return fmt.Errorf("error sweeping EC2 Internet Gateways (%s): %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
 
-func sweepKeyPairs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func sweepKeyPairs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.EC2Conn(ctx)
 	input := &ec2.DescribeKeyPairsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
 	output, err := conn.DescribeKeyPairsWithContext(ctx, input)
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping EC2 Key Pair sweep for %s: %s", region, err)
-		return nil
-	}
-
 	if err != nil {
-		return fmt.Errorf("error listing EC2 Key Pairs (%s): %w", region, err)
+		return nil, err
 	}
 
 	for _, v := range output.KeyPairs {
@@ -1116,26 +998,15 @@ func sweepKeyPairs(region string) error {
 		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping EC2 Key Pairs (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
-func sweepLaunchTemplates(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func sweepLaunchTemplates(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.EC2Conn(ctx)
 	input := &ec2.DescribeLaunchTemplatesInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.DescribeLaunchTemplatesPagesWithContext(ctx, input, func(page *ec2.DescribeLaunchTemplatesOutput, lastPage bool) bool {
+	err := conn.DescribeLaunchTemplatesPagesWithContext(ctx, input, func(page *ec2.DescribeLaunchTemplatesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -1151,35 +1022,19 @@ func sweepLaunchTemplates(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping EC2 Launch Template sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("error listing EC2 Launch Templates (%s): %w", region, err)
-	}
-
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
 	if err != nil {
-		return fmt.Errorf("error sweeping EC2 Launch Templates (%s): %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
 
-func sweepNATGateways(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func sweepNATGateways(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	input := &ec2.DescribeNatGatewaysInput{}
 	conn := client.EC2Conn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.DescribeNatGatewaysPagesWithContext(ctx, input, func(page *ec2.DescribeNatGatewaysOutput, lastPage bool) bool {
+	err := conn.DescribeNatGatewaysPagesWithContext(ctx, input, func(page *ec2.DescribeNatGatewaysOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -1195,35 +1050,19 @@ func sweepNATGateways(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping EC2 NAT Gateway sweep for %s: %s", region, err)
-		return nil
-	}
-
 	if err != nil {
-		return fmt.Errorf("error listing EC2 NAT Gateways (%s): %w", region, err)
+		return nil, err
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping EC2 NAT Gateways (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
-func sweepNetworkACLs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func sweepNetworkACLs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	input := &ec2.DescribeNetworkAclsInput{}
 	conn := client.EC2Conn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.DescribeNetworkAclsPagesWithContext(ctx, input, func(page *ec2.DescribeNetworkAclsOutput, lastPage bool) bool {
+	err := conn.DescribeNetworkAclsPagesWithContext(ctx, input, func(page *ec2.DescribeNetworkAclsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -1251,22 +1090,11 @@ func sweepNetworkACLs(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping EC2 Network ACL sweep for %s: %s", region, err)
-		return nil
-	}
-
 	if err != nil {
-		return fmt.Errorf("error listing EC2 Network ACLs (%s): %w", region, err)
+		return nil, err
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping EC2 Network ACLs (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func sweepNetworkInterfaces(region string) error {
@@ -1714,17 +1542,12 @@ func sweepSpotInstanceRequests(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepSubnets(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
-	}
+func sweepSubnets(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.EC2Conn(ctx)
 	input := &ec2.DescribeSubnetsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.DescribeSubnetsPagesWithContext(ctx, input, func(page *ec2.DescribeSubnetsOutput, lastPage bool) bool {
+	err := conn.DescribeSubnetsPagesWithContext(ctx, input, func(page *ec2.DescribeSubnetsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -1745,22 +1568,11 @@ func sweepSubnets(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping EC2 Subnet sweep for %s: %s", region, err)
-		return nil
-	}
-
 	if err != nil {
-		return fmt.Errorf("error listing EC2 Subnets (%s): %w", region, err)
+		return nil, err
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping EC2 Subnets (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func sweepTrafficMirrorFilters(region string) error {
@@ -2394,17 +2206,12 @@ func sweepVPCPeeringConnections(region string) error {
 	return nil
 }
 
-func sweepVPCs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func sweepVPCs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.EC2Conn(ctx)
 	input := &ec2.DescribeVpcsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.DescribeVpcsPagesWithContext(ctx, input, func(page *ec2.DescribeVpcsOutput, lastPage bool) bool {
+	err := conn.DescribeVpcsPagesWithContext(ctx, input, func(page *ec2.DescribeVpcsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -2425,22 +2232,11 @@ func sweepVPCs(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping EC2 VPC sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("error listing EC2 VPCs (%s): %w", region, err)
-	}
-
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
 	if err != nil {
-		return fmt.Errorf("error sweeping EC2 VPCs (%s): %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
 
 func sweepVPNConnections(region string) error {
@@ -2805,3 +2601,147 @@ func sweepInstanceConnectEndpoints(region string) error {
 
 	return nil
 }
+
+func sweepVerifiedAccessEndpoints(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.EC2Conn(ctx)
+	input := &ec2.DescribeVerifiedAccessEndpointsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err := conn.DescribeVerifiedAccessEndpointsPagesWithContext(ctx, input, func(page *ec2.DescribeVerifiedAccessEndpointsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VerifiedAccessEndpoints {
+			r := ResourceVerifiedAccessEndpoint()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.VerifiedAccessEndpointId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepVerifiedAccessGroups(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.EC2Conn(ctx)
+	input := &ec2.DescribeVerifiedAccessGroupsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err := conn.DescribeVerifiedAccessGroupsPagesWithContext(ctx, input, func(page *ec2.DescribeVerifiedAccessGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VerifiedAccessGroups {
+			r := ResourceVerifiedAccessGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.VerifiedAccessGroupId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepVerifiedAccessInstances(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.EC2Conn(ctx)
+	input := &ec2.DescribeVerifiedAccessInstancesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err := conn.DescribeVerifiedAccessInstancesPagesWithContext(ctx, input, func(page *ec2.DescribeVerifiedAccessInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VerifiedAccessInstances {
+			r := ResourceVerifiedAccessInstance()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.VerifiedAccessInstanceId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepVerifiedAccessInstanceTrustProviderAttachments(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.EC2Conn(ctx)
+	input := &ec2.DescribeVerifiedAccessInstancesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err := conn.DescribeVerifiedAccessInstancesPagesWithContext(ctx, input, func(page *ec2.DescribeVerifiedAccessInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VerifiedAccessInstances {
+			instanceID := aws.StringValue(v.VerifiedAccessInstanceId)
+
+			for _, v := range v.VerifiedAccessTrustProviders {
+				r := ResourceVerifiedAccessInstanceTrustProviderAttachment()
+				d := r.Data(nil)
+				d.SetId(VerifiedAccessInstanceTrustProviderAttachmentCreateResourceID(instanceID, aws.StringValue(v.VerifiedAccessTrustProviderId)))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepVerifiedAccessTrustProviders(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.EC2Conn(ctx)
+	input := &ec2.DescribeVerifiedAccessTrustProvidersInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err := conn.DescribeVerifiedAccessTrustProvidersPagesWithContext(ctx, input, func(page *ec2.DescribeVerifiedAccessTrustProvidersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VerifiedAccessTrustProviders {
+			r := ResourceVerifiedAccessTrustProvider()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.VerifiedAccessTrustProviderId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
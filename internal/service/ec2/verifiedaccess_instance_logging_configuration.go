@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_verifiedaccess_instance_logging_configuration", name="Verified Access Instance Logging Configuration")
+func ResourceVerifiedAccessInstanceLoggingConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVerifiedAccessInstanceLoggingConfigurationCreate,
+		ReadWithoutTimeout:   resourceVerifiedAccessInstanceLoggingConfigurationRead,
+		UpdateWithoutTimeout: resourceVerifiedAccessInstanceLoggingConfigurationUpdate,
+		DeleteWithoutTimeout: resourceVerifiedAccessInstanceLoggingConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_logs": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_logs": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"log_group": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"include_trust_context": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"kinesis_data_firehose": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delivery_stream": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+						"log_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"s3": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"bucket_owner": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"verifiedaccess_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceVerifiedAccessInstanceLoggingConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	instanceID := d.Get("verifiedaccess_instance_id").(string)
+	input := &ec2.ModifyVerifiedAccessInstanceLoggingConfigurationInput{
+		ClientToken:              aws.String(id.UniqueId()),
+		VerifiedAccessInstanceId: aws.String(instanceID),
+	}
+
+	if v, ok := d.GetOk("access_logs"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AccessLogs = expandVerifiedAccessLogOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.ModifyVerifiedAccessInstanceLoggingConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Verified Access Instance (%s) Logging Configuration: %s", instanceID, err)
+	}
+
+	d.SetId(instanceID)
+
+	return append(diags, resourceVerifiedAccessInstanceLoggingConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceVerifiedAccessInstanceLoggingConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	output, err := FindVerifiedAccessInstanceLoggingConfigurationByInstanceID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Verified Access Instance Logging Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Verified Access Instance Logging Configuration (%s): %s", d.Id(), err)
+	}
+
+	if v := output.AccessLogs; v != nil {
+		if err := d.Set("access_logs", []interface{}{flattenVerifiedAccessLogs(v)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting access_logs: %s", err)
+		}
+	} else {
+		d.Set("access_logs", nil)
+	}
+	d.Set("verifiedaccess_instance_id", output.VerifiedAccessInstanceId)
+
+	return diags
+}
+
+func resourceVerifiedAccessInstanceLoggingConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	input := &ec2.ModifyVerifiedAccessInstanceLoggingConfigurationInput{
+		ClientToken:              aws.String(id.UniqueId()),
+		VerifiedAccessInstanceId: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("access_logs"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AccessLogs = expandVerifiedAccessLogOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.ModifyVerifiedAccessInstanceLoggingConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Verified Access Instance Logging Configuration (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceVerifiedAccessInstanceLoggingConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceVerifiedAccessInstanceLoggingConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	// Deleting the logging configuration disables all log destinations.
+	log.Printf("[INFO] Deleting Verified Access Instance Logging Configuration: %s", d.Id())
+	_, err := conn.ModifyVerifiedAccessInstanceLoggingConfiguration(ctx, &ec2.ModifyVerifiedAccessInstanceLoggingConfigurationInput{
+		AccessLogs: &types.VerifiedAccessLogOptions{
+			CloudWatchLogs: &types.VerifiedAccessLogCloudWatchLogsDestinationOptions{
+				Enabled: aws.Bool(false),
+			},
+			IncludeTrustContext: aws.Bool(false),
+			KinesisDataFirehose: &types.VerifiedAccessLogKinesisDataFirehoseDestinationOptions{
+				Enabled: aws.Bool(false),
+			},
+			S3: &types.VerifiedAccessLogS3DestinationOptions{
+				Enabled: aws.Bool(false),
+			},
+		},
+		ClientToken:              aws.String(id.UniqueId()),
+		VerifiedAccessInstanceId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessInstanceIdNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Verified Access Instance Logging Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandVerifiedAccessLogOptions(tfMap map[string]interface{}) *types.VerifiedAccessLogOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.VerifiedAccessLogOptions{}
+
+	if v, ok := tfMap["cloudwatch_logs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.CloudWatchLogs = &types.VerifiedAccessLogCloudWatchLogsDestinationOptions{
+			Enabled: aws.Bool(tfMap["enabled"].(bool)),
+		}
+
+		if v, ok := tfMap["log_group"].(string); ok && v != "" {
+			apiObject.CloudWatchLogs.LogGroup = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["include_trust_context"].(bool); ok {
+		apiObject.IncludeTrustContext = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["kinesis_data_firehose"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.KinesisDataFirehose = &types.VerifiedAccessLogKinesisDataFirehoseDestinationOptions{
+			Enabled: aws.Bool(tfMap["enabled"].(bool)),
+		}
+
+		if v, ok := tfMap["delivery_stream"].(string); ok && v != "" {
+			apiObject.KinesisDataFirehose.DeliveryStream = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["log_version"].(string); ok && v != "" {
+		apiObject.LogVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.S3 = &types.VerifiedAccessLogS3DestinationOptions{
+			Enabled: aws.Bool(tfMap["enabled"].(bool)),
+		}
+
+		if v, ok := tfMap["bucket_name"].(string); ok && v != "" {
+			apiObject.S3.BucketName = aws.String(v)
+		}
+
+		if v, ok := tfMap["bucket_owner"].(string); ok && v != "" {
+			apiObject.S3.BucketOwner = aws.String(v)
+		}
+
+		if v, ok := tfMap["prefix"].(string); ok && v != "" {
+			apiObject.S3.Prefix = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenVerifiedAccessLogs(apiObject *types.VerifiedAccessLogs) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CloudWatchLogs; v != nil && aws.ToBool(v.Enabled) {
+		tfMap["cloudwatch_logs"] = []interface{}{map[string]interface{}{
+			"enabled":   aws.ToBool(v.Enabled),
+			"log_group": aws.ToString(v.LogGroup),
+		}}
+	}
+
+	if v := apiObject.IncludeTrustContext; v != nil {
+		tfMap["include_trust_context"] = aws.ToBool(v)
+	}
+
+	if v := apiObject.KinesisDataFirehose; v != nil && aws.ToBool(v.Enabled) {
+		tfMap["kinesis_data_firehose"] = []interface{}{map[string]interface{}{
+			"delivery_stream": aws.ToString(v.DeliveryStream),
+			"enabled":         aws.ToBool(v.Enabled),
+		}}
+	}
+
+	if v := apiObject.LogVersion; v != nil {
+		tfMap["log_version"] = aws.ToString(v)
+	}
+
+	if v := apiObject.S3; v != nil && aws.ToBool(v.Enabled) {
+		tfMap["s3"] = []interface{}{map[string]interface{}{
+			"bucket_name":  aws.ToString(v.BucketName),
+			"bucket_owner": aws.ToString(v.BucketOwner),
+			"enabled":      aws.ToBool(v.Enabled),
+			"prefix":       aws.ToString(v.Prefix),
+		}}
+	}
+
+	return tfMap
+}
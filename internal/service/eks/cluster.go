@@ -53,6 +53,28 @@ func ResourceCluster() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"access_config": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authentication_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(eks.AuthenticationMode_Values(), false),
+						},
+						"bootstrap_cluster_creator_admin_permissions": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  true,
+						},
+					},
+				},
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -304,6 +326,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		Tags:               getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("access_config"); ok {
+		input.AccessConfig = expandCreateAccessConfigRequest(v.([]interface{}))
+	}
+
 	if _, ok := d.GetOk("kubernetes_network_config"); ok {
 		input.KubernetesNetworkConfig = expandKubernetesNetworkConfigRequest(d.Get("kubernetes_network_config").([]interface{}))
 	}
@@ -377,6 +403,14 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.Errorf("reading EKS Cluster (%s): %s", d.Id(), err)
 	}
 
+	// DescribeCluster doesn't always return the bootstrap setting, so default to the value in state.
+	bootstrapClusterCreatorAdminPermissions := true
+	if v, ok := d.Get("access_config").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		bootstrapClusterCreatorAdminPermissions = v[0].(map[string]interface{})["bootstrap_cluster_creator_admin_permissions"].(bool)
+	}
+	if err := d.Set("access_config", flattenAccessConfigResponse(cluster.AccessConfig, bootstrapClusterCreatorAdminPermissions)); err != nil {
+		return diag.Errorf("setting access_config: %s", err)
+	}
 	d.Set("arn", cluster.Arn)
 	if err := d.Set("certificate_authority", flattenCertificate(cluster.CertificateAuthority)); err != nil {
 		return diag.Errorf("setting certificate_authority: %s", err)
@@ -441,6 +475,34 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("access_config.0.authentication_mode") {
+		authenticationMode := d.Get("access_config.0.authentication_mode").(string)
+		input := &eks.UpdateClusterConfigInput{
+			AccessConfig: &eks.UpdateAccessConfigRequest{
+				AuthenticationMode: aws.String(authenticationMode),
+			},
+			Name: aws.String(d.Id()),
+		}
+
+		output, err := conn.UpdateClusterConfigWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating EKS Cluster (%s) authentication mode: %s", d.Id(), err)
+		}
+
+		updateID := aws.StringValue(output.Update.Id)
+
+		_, err = waitClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return diag.Errorf("waiting for EKS Cluster (%s) authentication mode update (%s): %s", d.Id(), updateID, err)
+		}
+
+		if _, err := waitClusterAuthenticationModeUpdated(ctx, conn, d.Id(), authenticationMode, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for EKS Cluster (%s) authentication mode (%s): %s", d.Id(), authenticationMode, err)
+		}
+	}
+
 	if d.HasChange("encryption_config") {
 		o, n := d.GetChange("encryption_config")
 
@@ -703,6 +765,26 @@ func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.EKS, name, id st
 	return nil, err
 }
 
+func expandCreateAccessConfigRequest(tfList []interface{}) *eks.CreateAccessConfigRequest {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &eks.CreateAccessConfigRequest{}
+
+	if v, ok := tfMap["authentication_mode"].(string); ok && v != "" {
+		apiObject.AuthenticationMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["bootstrap_cluster_creator_admin_permissions"].(bool); ok {
+		apiObject.BootstrapClusterCreatorAdminPermissions = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
 func expandEncryptionConfig(tfList []interface{}) []*eks.EncryptionConfig {
 	if len(tfList) == 0 {
 		return nil
@@ -872,6 +954,23 @@ func expandLogging(vEnabledLogTypes *schema.Set) *eks.Logging {
 	}
 }
 
+func flattenAccessConfigResponse(apiObject *eks.AccessConfigResponse, bootstrapClusterCreatorAdminPermissions bool) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"authentication_mode":                         aws.StringValue(apiObject.AuthenticationMode),
+		"bootstrap_cluster_creator_admin_permissions": bootstrapClusterCreatorAdminPermissions,
+	}
+
+	if v := apiObject.BootstrapClusterCreatorAdminPermissions; v != nil {
+		tfMap["bootstrap_cluster_creator_admin_permissions"] = aws.BoolValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenCertificate(certificate *eks.Certificate) []map[string]interface{} {
 	if certificate == nil {
 		return []map[string]interface{}{}
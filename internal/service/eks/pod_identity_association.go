@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_eks_pod_identity_association", name="Pod Identity Association")
+// @Tags(identifierAttribute="association_arn")
+func ResourcePodIdentityAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePodIdentityAssociationCreate,
+		ReadWithoutTimeout:   resourcePodIdentityAssociationRead,
+		UpdateWithoutTimeout: resourcePodIdentityAssociationUpdate,
+		DeleteWithoutTimeout: resourcePodIdentityAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"association_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validClusterName,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"service_account": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourcePodIdentityAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName := d.Get("cluster_name").(string)
+	input := &eks.CreatePodIdentityAssociationInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		ClusterName:        aws.String(clusterName),
+		Namespace:          aws.String(d.Get("namespace").(string)),
+		RoleArn:            aws.String(d.Get("role_arn").(string)),
+		ServiceAccount:     aws.String(d.Get("service_account").(string)),
+		Tags:               aws.ToStringMap(getTagsIn(ctx)),
+	}
+
+	output, err := conn.CreatePodIdentityAssociation(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EKS Pod Identity Association (%s): %s", clusterName, err)
+	}
+
+	d.SetId(PodIdentityAssociationCreateResourceID(clusterName, aws.ToString(output.Association.AssociationId)))
+
+	return append(diags, resourcePodIdentityAssociationRead(ctx, d, meta)...)
+}
+
+func resourcePodIdentityAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName, associationID, err := PodIdentityAssociationParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindPodIdentityAssociationByTwoPartKey(ctx, conn, clusterName, associationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EKS Pod Identity Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Pod Identity Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("association_arn", output.AssociationArn)
+	d.Set("association_id", output.AssociationId)
+	d.Set("cluster_name", output.ClusterName)
+	d.Set("namespace", output.Namespace)
+	d.Set("role_arn", output.RoleArn)
+	d.Set("service_account", output.ServiceAccount)
+
+	setTagsOut(ctx, aws.StringMap(output.Tags))
+
+	return diags
+}
+
+func resourcePodIdentityAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	if d.HasChange("role_arn") {
+		clusterName, associationID, err := PodIdentityAssociationParseResourceID(d.Id())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &eks.UpdatePodIdentityAssociationInput{
+			AssociationId:      aws.String(associationID),
+			ClientRequestToken: aws.String(sdkid.UniqueId()),
+			ClusterName:        aws.String(clusterName),
+			RoleArn:            aws.String(d.Get("role_arn").(string)),
+		}
+
+		_, err = conn.UpdatePodIdentityAssociation(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EKS Pod Identity Association (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePodIdentityAssociationRead(ctx, d, meta)...)
+}
+
+func resourcePodIdentityAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName, associationID, err := PodIdentityAssociationParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting EKS Pod Identity Association: %s", d.Id())
+	_, err = conn.DeletePodIdentityAssociation(ctx, &eks.DeletePodIdentityAssociationInput{
+		AssociationId: aws.String(associationID),
+		ClusterName:   aws.String(clusterName),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EKS Pod Identity Association (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindPodIdentityAssociationByTwoPartKey(ctx context.Context, conn *eks.Client, clusterName, associationID string) (*types.PodIdentityAssociation, error) {
+	input := &eks.DescribePodIdentityAssociationInput{
+		AssociationId: aws.String(associationID),
+		ClusterName:   aws.String(clusterName),
+	}
+
+	output, err := conn.DescribePodIdentityAssociation(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Association == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Association, nil
+}
@@ -107,3 +107,23 @@ func statusOIDCIdentityProviderConfig(ctx context.Context, conn *eks.EKS, cluste
 		return output, aws.StringValue(output.Status), nil
 	}
 }
+
+func statusClusterAuthenticationMode(ctx context.Context, conn *eks.EKS, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindClusterByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.AccessConfig == nil {
+			return output, "", nil
+		}
+
+		return output, aws.StringValue(output.AccessConfig.AuthenticationMode), nil
+	}
+}
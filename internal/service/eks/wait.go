@@ -210,3 +210,29 @@ func waitOIDCIdentityProviderConfigDeleted(ctx context.Context, conn *eks.EKS, c
 
 	return nil, err
 }
+
+// waitClusterAuthenticationModeUpdated waits for a cluster's authentication mode to transition to the specified mode.
+// Authentication modes can only change from CONFIG_MAP to API_AND_CONFIG_MAP to API.
+func waitClusterAuthenticationModeUpdated(ctx context.Context, conn *eks.EKS, name, authenticationMode string, timeout time.Duration) (*eks.Cluster, error) {
+	var pending []string
+	for _, v := range eks.AuthenticationMode_Values() {
+		if v != authenticationMode {
+			pending = append(pending, v)
+		}
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: pending,
+		Target:  []string{authenticationMode},
+		Refresh: statusClusterAuthenticationMode(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*eks.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -24,3 +24,8 @@ func (p *servicePackage) NewConn(ctx context.Context, m map[string]any) (*global
 
 	return globalaccelerator_sdkv1.New(sess.Copy(config)), nil
 }
+
+// IsGlobal returns true as Global Accelerator resources are not Regional.
+func (p *servicePackage) IsGlobal() bool {
+	return true
+}
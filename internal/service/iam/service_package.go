@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+// IsGlobal returns true as IAM resources are not Regional.
+func (p *servicePackage) IsGlobal() bool {
+	return true
+}
@@ -0,0 +1,392 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfinspector2 "github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccCISScanConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.CisScanConfiguration
+	resourceName := "aws_inspector2_cis_scan_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCISScanConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCISScanConfigurationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "inspector2", regexp.MustCompile(`owner/\d{12}/cis-configuration/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "scan_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.one_time.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_level", string(types.CisSecurityLevelLevel1)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "targets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "targets.0.account_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "targets.0.account_ids.*", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "targets.0.target_resource_tags.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "targets.0.target_resource_tags.*", map[string]string{
+						"key":      "Name",
+						"values.#": "1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCISScanConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.CisScanConfiguration
+	resourceName := "aws_inspector2_cis_scan_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCISScanConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCISScanConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfinspector2.ResourceCISScanConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCISScanConfiguration_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.CisScanConfiguration
+	resourceName := "aws_inspector2_cis_scan_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCISScanConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCISScanConfigurationConfig_daily(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "scan_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.daily.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.daily.0.start_time.0.time_of_day", "12:00"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.daily.0.start_time.0.timezone", "UTC"),
+					resource.TestCheckResourceAttr(resourceName, "security_level", string(types.CisSecurityLevelLevel1)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCISScanConfigurationConfig_weekly(rNameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "scan_name", rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.daily.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.weekly.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.weekly.0.days.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "schedule.0.weekly.0.days.*", string(types.DayMon)),
+					resource.TestCheckTypeSetElemAttr(resourceName, "schedule.0.weekly.0.days.*", string(types.DayFri)),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.weekly.0.start_time.0.time_of_day", "23:30"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.weekly.0.start_time.0.timezone", "Europe/Paris"),
+					resource.TestCheckResourceAttr(resourceName, "security_level", string(types.CisSecurityLevelLevel2)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCISScanConfiguration_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.CisScanConfiguration
+	resourceName := "aws_inspector2_cis_scan_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCISScanConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCISScanConfigurationConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCISScanConfigurationConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccCISScanConfigurationConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCISScanConfigurationExists(ctx context.Context, n string, v *types.CisScanConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Amazon Inspector CIS Scan Configuration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client(ctx)
+
+		output, err := tfinspector2.FindCISScanConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCISScanConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_inspector2_cis_scan_configuration" {
+				continue
+			}
+
+			_, err := tfinspector2.FindCISScanConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Amazon Inspector CIS Scan Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCISScanConfigurationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_cis_scan_configuration" "test" {
+  scan_name      = %[1]q
+  security_level = "LEVEL_1"
+
+  schedule {
+    one_time {}
+  }
+
+  targets {
+    account_ids = [data.aws_caller_identity.current.account_id]
+
+    target_resource_tags {
+      key    = "Name"
+      values = [%[1]q]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCISScanConfigurationConfig_daily(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_cis_scan_configuration" "test" {
+  scan_name      = %[1]q
+  security_level = "LEVEL_1"
+
+  schedule {
+    daily {
+      start_time {
+        time_of_day = "12:00"
+      }
+    }
+  }
+
+  targets {
+    account_ids = [data.aws_caller_identity.current.account_id]
+
+    target_resource_tags {
+      key    = "Name"
+      values = [%[1]q]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCISScanConfigurationConfig_weekly(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_cis_scan_configuration" "test" {
+  scan_name      = %[1]q
+  security_level = "LEVEL_2"
+
+  schedule {
+    weekly {
+      days = ["MON", "FRI"]
+
+      start_time {
+        time_of_day = "23:30"
+        timezone    = "Europe/Paris"
+      }
+    }
+  }
+
+  targets {
+    account_ids = [data.aws_caller_identity.current.account_id]
+
+    target_resource_tags {
+      key    = "Name"
+      values = [%[1]q]
+    }
+
+    target_resource_tags {
+      key    = "Environment"
+      values = ["test", "staging"]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCISScanConfigurationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_cis_scan_configuration" "test" {
+  scan_name      = %[1]q
+  security_level = "LEVEL_1"
+
+  schedule {
+    one_time {}
+  }
+
+  targets {
+    account_ids = [data.aws_caller_identity.current.account_id]
+
+    target_resource_tags {
+      key    = "Name"
+      values = [%[1]q]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccCISScanConfigurationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_cis_scan_configuration" "test" {
+  scan_name      = %[1]q
+  security_level = "LEVEL_1"
+
+  schedule {
+    one_time {}
+  }
+
+  targets {
+    account_ids = [data.aws_caller_identity.current.account_id]
+
+    target_resource_tags {
+      key    = "Name"
+      values = [%[1]q]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// @SDKDataSource("aws_inspector2_coverage", name="Coverage")
+func DataSourceCoverage() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCoverageRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"covered_resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_scanned_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scan_status": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"reason": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status_code": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"scan_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"filter_criteria": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id":              coverageStringFilterSchema(),
+						"ec2_instance_tags":       coverageMapFilterSchema(),
+						"ecr_image_tags":          coverageStringFilterSchema(),
+						"ecr_repository_name":     coverageStringFilterSchema(),
+						"lambda_function_name":    coverageStringFilterSchema(),
+						"lambda_function_runtime": coverageStringFilterSchema(),
+						"lambda_function_tags":    coverageMapFilterSchema(),
+						"last_scanned_at":         dateFilterSchema(),
+						"resource_id":             coverageStringFilterSchema(),
+						"resource_type":           coverageStringFilterSchema(),
+						"scan_status_code":        coverageStringFilterSchema(),
+						"scan_status_reason":      coverageStringFilterSchema(),
+						"scan_type":               coverageStringFilterSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func coverageStringFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"comparison": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[types.CoverageStringComparison](),
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func coverageMapFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"comparison": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[types.CoverageMapComparison](),
+				},
+				"key": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCoverageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*conns.AWSClient)
+	conn := client.Inspector2Client(ctx)
+
+	// Coverage is incomplete while Amazon Inspector is still being enabled for the account
+	// or any of its resource types, so wait for the enabler to settle first.
+	accountID := client.AccountID
+	statuses, err := AccountStatuses(ctx, conn, []string{accountID})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Amazon Inspector status (%s): %s", accountID, err)
+	}
+
+	if status := statuses[accountID]; status.Status == types.StatusDisabled {
+		return sdkdiag.AppendErrorf(diags, "Amazon Inspector is not enabled for account (%s)", accountID)
+	}
+
+	if tfslices.Any(maps.Values(statuses), func(v AccountResourceStatus) bool {
+		return slices.Contains(pendingStates, v.Status) || tfslices.Any(maps.Values(v.ResourceStatuses), func(v types.Status) bool {
+			return slices.Contains(pendingStates, v)
+		})
+	}) {
+		if _, err := waitEnabled(ctx, conn, []string{accountID}, d.Timeout(schema.TimeoutRead)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Amazon Inspector (%s) enablement: %s", accountID, err)
+		}
+	}
+
+	input := &inspector2.ListCoverageInput{}
+
+	if v, ok := d.GetOk("filter_criteria"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.FilterCriteria = expandCoverageFilterCriteria(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := findCoveredResources(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Amazon Inspector Coverage: %s", err)
+	}
+
+	d.SetId(accountID)
+	if err := d.Set("covered_resources", flattenCoveredResources(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting covered_resources: %s", err)
+	}
+
+	return diags
+}
+
+func findCoveredResources(ctx context.Context, conn *inspector2.Client, input *inspector2.ListCoverageInput) ([]types.CoveredResource, error) {
+	var output []types.CoveredResource
+
+	pages := inspector2.NewListCoveragePaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CoveredResources...)
+	}
+
+	return output, nil
+}
+
+func expandCoverageFilterCriteria(tfMap map[string]interface{}) *types.CoverageFilterCriteria {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.CoverageFilterCriteria{
+		AccountId:             expandCoverageStringFilters(tfMap["account_id"].([]interface{})),
+		Ec2InstanceTags:       expandCoverageMapFilters(tfMap["ec2_instance_tags"].([]interface{})),
+		EcrImageTags:          expandCoverageStringFilters(tfMap["ecr_image_tags"].([]interface{})),
+		EcrRepositoryName:     expandCoverageStringFilters(tfMap["ecr_repository_name"].([]interface{})),
+		LambdaFunctionName:    expandCoverageStringFilters(tfMap["lambda_function_name"].([]interface{})),
+		LambdaFunctionRuntime: expandCoverageStringFilters(tfMap["lambda_function_runtime"].([]interface{})),
+		LambdaFunctionTags:    expandCoverageMapFilters(tfMap["lambda_function_tags"].([]interface{})),
+		ResourceId:            expandCoverageStringFilters(tfMap["resource_id"].([]interface{})),
+		ResourceType:          expandCoverageStringFilters(tfMap["resource_type"].([]interface{})),
+		ScanStatusCode:        expandCoverageStringFilters(tfMap["scan_status_code"].([]interface{})),
+		ScanStatusReason:      expandCoverageStringFilters(tfMap["scan_status_reason"].([]interface{})),
+		ScanType:              expandCoverageStringFilters(tfMap["scan_type"].([]interface{})),
+	}
+
+	for _, v := range expandDateFilters(tfMap["last_scanned_at"].([]interface{})) {
+		apiObject.LastScannedAt = append(apiObject.LastScannedAt, types.CoverageDateFilter{
+			EndInclusive:   v.EndInclusive,
+			StartInclusive: v.StartInclusive,
+		})
+	}
+
+	return apiObject
+}
+
+func expandCoverageStringFilters(tfList []interface{}) []types.CoverageStringFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.CoverageStringFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.CoverageStringFilter{}
+
+		if v, ok := tfMap["comparison"].(string); ok && v != "" {
+			apiObject.Comparison = types.CoverageStringComparison(v)
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandCoverageMapFilters(tfList []interface{}) []types.CoverageMapFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.CoverageMapFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.CoverageMapFilter{}
+
+		if v, ok := tfMap["comparison"].(string); ok && v != "" {
+			apiObject.Comparison = types.CoverageMapComparison(v)
+		}
+
+		if v, ok := tfMap["key"].(string); ok && v != "" {
+			apiObject.Key = aws.String(v)
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenCoveredResources(apiObjects []types.CoveredResource) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"account_id":    aws.ToString(apiObject.AccountId),
+			"resource_id":   aws.ToString(apiObject.ResourceId),
+			"resource_type": string(apiObject.ResourceType),
+			"scan_type":     string(apiObject.ScanType),
+		}
+
+		if v := apiObject.LastScannedAt; v != nil {
+			tfMap["last_scanned_at"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.ScanStatus; v != nil {
+			tfMap["scan_status"] = []interface{}{map[string]interface{}{
+				"reason":      string(v.Reason),
+				"status_code": string(v.StatusCode),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
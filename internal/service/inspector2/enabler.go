@@ -420,7 +420,7 @@ func waitEnabled(ctx context.Context, conn *inspector2.Client, accountIDs []stri
 	}
 
 	raw, err := stateConf.WaitForStateContext(ctx)
-	result := raw.(map[string]AccountResourceStatus)
+	result, _ := raw.(map[string]AccountResourceStatus)
 	return result, err
 }
 
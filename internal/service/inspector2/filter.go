@@ -0,0 +1,927 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_inspector2_filter", name="Filter")
+// @Tags(identifierAttribute="arn")
+func ResourceFilter() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFilterCreate,
+		ReadWithoutTimeout:   resourceFilterRead,
+		UpdateWithoutTimeout: resourceFilterUpdate,
+		DeleteWithoutTimeout: resourceFilterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.FilterAction](),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"filter_criteria": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_account_id":                     stringFilterSchema(),
+						"code_vulnerability_detector_name":   stringFilterSchema(),
+						"code_vulnerability_detector_tags":   stringFilterSchema(),
+						"code_vulnerability_file_path":       stringFilterSchema(),
+						"component_id":                       stringFilterSchema(),
+						"component_type":                     stringFilterSchema(),
+						"ec2_instance_image_id":              stringFilterSchema(),
+						"ec2_instance_subnet_id":             stringFilterSchema(),
+						"ec2_instance_vpc_id":                stringFilterSchema(),
+						"ecr_image_architecture":             stringFilterSchema(),
+						"ecr_image_hash":                     stringFilterSchema(),
+						"ecr_image_pushed_at":                dateFilterSchema(),
+						"ecr_image_registry":                 stringFilterSchema(),
+						"ecr_image_repository_name":          stringFilterSchema(),
+						"ecr_image_tags":                     stringFilterSchema(),
+						"epss_score":                         numberFilterSchema(),
+						"exploit_available":                  stringFilterSchema(),
+						"finding_arn":                        stringFilterSchema(),
+						"finding_status":                     stringFilterSchema(),
+						"finding_type":                       stringFilterSchema(),
+						"first_observed_at":                  dateFilterSchema(),
+						"fix_available":                      stringFilterSchema(),
+						"inspector_score":                    numberFilterSchema(),
+						"lambda_function_execution_role_arn": stringFilterSchema(),
+						"lambda_function_last_modified_at":   dateFilterSchema(),
+						"lambda_function_layers":             stringFilterSchema(),
+						"lambda_function_name":               stringFilterSchema(),
+						"lambda_function_runtime":            stringFilterSchema(),
+						"last_observed_at":                   dateFilterSchema(),
+						"network_protocol":                   stringFilterSchema(),
+						"port_range":                         portRangeFilterSchema(),
+						"related_vulnerabilities":            stringFilterSchema(),
+						"resource_id":                        stringFilterSchema(),
+						"resource_tags":                      mapFilterSchema(),
+						"resource_type":                      stringFilterSchema(),
+						"severity":                           stringFilterSchema(),
+						"title":                              stringFilterSchema(),
+						"updated_at":                         dateFilterSchema(),
+						"vendor_severity":                    stringFilterSchema(),
+						"vulnerability_id":                   stringFilterSchema(),
+						"vulnerability_source":               stringFilterSchema(),
+						"vulnerable_packages":                packageFilterSchema(),
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"reason": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func stringFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"comparison": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[types.StringComparison](),
+				},
+				"value": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+			},
+		},
+	}
+}
+
+func singleStringFilterSchema() *schema.Schema {
+	s := stringFilterSchema()
+	s.MaxItems = 1
+
+	return s
+}
+
+func numberFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"lower_inclusive": {
+					Type:     schema.TypeFloat,
+					Optional: true,
+				},
+				"upper_inclusive": {
+					Type:     schema.TypeFloat,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func dateFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"end_inclusive": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidUTCTimestamp,
+				},
+				"start_inclusive": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidUTCTimestamp,
+				},
+			},
+		},
+	}
+}
+
+func mapFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"comparison": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[types.MapComparison](),
+				},
+				"key": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+				"value": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 256),
+				},
+			},
+		},
+	}
+}
+
+func portRangeFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"begin_inclusive": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IsPortNumberOrZero,
+				},
+				"end_inclusive": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IsPortNumberOrZero,
+				},
+			},
+		},
+	}
+}
+
+func packageFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"architecture": singleStringFilterSchema(),
+				"epoch": func() *schema.Schema {
+					s := numberFilterSchema()
+					s.MaxItems = 1
+					return s
+				}(),
+				"name":                    singleStringFilterSchema(),
+				"release":                 singleStringFilterSchema(),
+				"source_lambda_layer_arn": singleStringFilterSchema(),
+				"source_layer_hash":       singleStringFilterSchema(),
+				"version":                 singleStringFilterSchema(),
+			},
+		},
+	}
+}
+
+func resourceFilterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	name := d.Get("name").(string)
+	input := &inspector2.CreateFilterInput{
+		Action: types.FilterAction(d.Get("action").(string)),
+		Name:   aws.String(name),
+		Tags:   getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("filter_criteria"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.FilterCriteria = expandFilterCriteria(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("reason"); ok {
+		input.Reason = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateFilter(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Amazon Inspector Filter (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Arn))
+
+	return append(diags, resourceFilterRead(ctx, d, meta)...)
+}
+
+func resourceFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	filter, err := FindFilterByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Amazon Inspector Filter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Amazon Inspector Filter (%s): %s", d.Id(), err)
+	}
+
+	d.Set("action", filter.Action)
+	d.Set("arn", filter.Arn)
+	d.Set("description", filter.Description)
+	if filter.Criteria != nil {
+		if err := d.Set("filter_criteria", []interface{}{flattenFilterCriteria(filter.Criteria)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting filter_criteria: %s", err)
+		}
+	} else {
+		d.Set("filter_criteria", nil)
+	}
+	d.Set("name", filter.Name)
+	d.Set("reason", filter.Reason)
+
+	setTagsOut(ctx, filter.Tags)
+
+	return diags
+}
+
+func resourceFilterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &inspector2.UpdateFilterInput{
+			FilterArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("action") {
+			input.Action = types.FilterAction(d.Get("action").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("filter_criteria") {
+			if v, ok := d.GetOk("filter_criteria"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.FilterCriteria = expandFilterCriteria(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("reason") {
+			input.Reason = aws.String(d.Get("reason").(string))
+		}
+
+		_, err := conn.UpdateFilter(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Amazon Inspector Filter (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceFilterRead(ctx, d, meta)...)
+}
+
+func resourceFilterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	log.Printf("[DEBUG] Deleting Amazon Inspector Filter: %s", d.Id())
+	_, err := conn.DeleteFilter(ctx, &inspector2.DeleteFilterInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Amazon Inspector Filter (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindFilterByARN(ctx context.Context, conn *inspector2.Client, arn string) (*types.Filter, error) {
+	input := &inspector2.ListFiltersInput{
+		Arns: []string{arn},
+	}
+
+	var output []types.Filter
+
+	pages := inspector2.NewListFiltersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Filters...)
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func expandFilterCriteria(tfMap map[string]interface{}) *types.FilterCriteria {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.FilterCriteria{
+		AwsAccountId:                   expandStringFilters(tfMap["aws_account_id"].([]interface{})),
+		CodeVulnerabilityDetectorName:  expandStringFilters(tfMap["code_vulnerability_detector_name"].([]interface{})),
+		CodeVulnerabilityDetectorTags:  expandStringFilters(tfMap["code_vulnerability_detector_tags"].([]interface{})),
+		CodeVulnerabilityFilePath:      expandStringFilters(tfMap["code_vulnerability_file_path"].([]interface{})),
+		ComponentId:                    expandStringFilters(tfMap["component_id"].([]interface{})),
+		ComponentType:                  expandStringFilters(tfMap["component_type"].([]interface{})),
+		Ec2InstanceImageId:             expandStringFilters(tfMap["ec2_instance_image_id"].([]interface{})),
+		Ec2InstanceSubnetId:            expandStringFilters(tfMap["ec2_instance_subnet_id"].([]interface{})),
+		Ec2InstanceVpcId:               expandStringFilters(tfMap["ec2_instance_vpc_id"].([]interface{})),
+		EcrImageArchitecture:           expandStringFilters(tfMap["ecr_image_architecture"].([]interface{})),
+		EcrImageHash:                   expandStringFilters(tfMap["ecr_image_hash"].([]interface{})),
+		EcrImagePushedAt:               expandDateFilters(tfMap["ecr_image_pushed_at"].([]interface{})),
+		EcrImageRegistry:               expandStringFilters(tfMap["ecr_image_registry"].([]interface{})),
+		EcrImageRepositoryName:         expandStringFilters(tfMap["ecr_image_repository_name"].([]interface{})),
+		EcrImageTags:                   expandStringFilters(tfMap["ecr_image_tags"].([]interface{})),
+		EpssScore:                      expandNumberFilters(tfMap["epss_score"].([]interface{})),
+		ExploitAvailable:               expandStringFilters(tfMap["exploit_available"].([]interface{})),
+		FindingArn:                     expandStringFilters(tfMap["finding_arn"].([]interface{})),
+		FindingStatus:                  expandStringFilters(tfMap["finding_status"].([]interface{})),
+		FindingType:                    expandStringFilters(tfMap["finding_type"].([]interface{})),
+		FirstObservedAt:                expandDateFilters(tfMap["first_observed_at"].([]interface{})),
+		FixAvailable:                   expandStringFilters(tfMap["fix_available"].([]interface{})),
+		InspectorScore:                 expandNumberFilters(tfMap["inspector_score"].([]interface{})),
+		LambdaFunctionExecutionRoleArn: expandStringFilters(tfMap["lambda_function_execution_role_arn"].([]interface{})),
+		LambdaFunctionLastModifiedAt:   expandDateFilters(tfMap["lambda_function_last_modified_at"].([]interface{})),
+		LambdaFunctionLayers:           expandStringFilters(tfMap["lambda_function_layers"].([]interface{})),
+		LambdaFunctionName:             expandStringFilters(tfMap["lambda_function_name"].([]interface{})),
+		LambdaFunctionRuntime:          expandStringFilters(tfMap["lambda_function_runtime"].([]interface{})),
+		LastObservedAt:                 expandDateFilters(tfMap["last_observed_at"].([]interface{})),
+		NetworkProtocol:                expandStringFilters(tfMap["network_protocol"].([]interface{})),
+		PortRange:                      expandPortRangeFilters(tfMap["port_range"].([]interface{})),
+		RelatedVulnerabilities:         expandStringFilters(tfMap["related_vulnerabilities"].([]interface{})),
+		ResourceId:                     expandStringFilters(tfMap["resource_id"].([]interface{})),
+		ResourceTags:                   expandMapFilters(tfMap["resource_tags"].([]interface{})),
+		ResourceType:                   expandStringFilters(tfMap["resource_type"].([]interface{})),
+		Severity:                       expandStringFilters(tfMap["severity"].([]interface{})),
+		Title:                          expandStringFilters(tfMap["title"].([]interface{})),
+		UpdatedAt:                      expandDateFilters(tfMap["updated_at"].([]interface{})),
+		VendorSeverity:                 expandStringFilters(tfMap["vendor_severity"].([]interface{})),
+		VulnerabilityId:                expandStringFilters(tfMap["vulnerability_id"].([]interface{})),
+		VulnerabilitySource:            expandStringFilters(tfMap["vulnerability_source"].([]interface{})),
+		VulnerablePackages:             expandPackageFilters(tfMap["vulnerable_packages"].([]interface{})),
+	}
+
+	return apiObject
+}
+
+func expandStringFilter(tfMap map[string]interface{}) *types.StringFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.StringFilter{}
+
+	if v, ok := tfMap["comparison"].(string); ok && v != "" {
+		apiObject.Comparison = types.StringComparison(v)
+	}
+
+	if v, ok := tfMap["value"].(string); ok && v != "" {
+		apiObject.Value = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandStringFilters(tfList []interface{}) []types.StringFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.StringFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *expandStringFilter(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandNumberFilter(tfMap map[string]interface{}) *types.NumberFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.NumberFilter{}
+
+	if v, ok := tfMap["lower_inclusive"].(float64); ok && v != 0 {
+		apiObject.LowerInclusive = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["upper_inclusive"].(float64); ok && v != 0 {
+		apiObject.UpperInclusive = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func expandNumberFilters(tfList []interface{}) []types.NumberFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.NumberFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *expandNumberFilter(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandDateFilters(tfList []interface{}) []types.DateFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.DateFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.DateFilter{}
+
+		if v, ok := tfMap["end_inclusive"].(string); ok && v != "" {
+			v, _ := time.Parse(time.RFC3339, v)
+			apiObject.EndInclusive = aws.Time(v)
+		}
+
+		if v, ok := tfMap["start_inclusive"].(string); ok && v != "" {
+			v, _ := time.Parse(time.RFC3339, v)
+			apiObject.StartInclusive = aws.Time(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMapFilters(tfList []interface{}) []types.MapFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.MapFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.MapFilter{}
+
+		if v, ok := tfMap["comparison"].(string); ok && v != "" {
+			apiObject.Comparison = types.MapComparison(v)
+		}
+
+		if v, ok := tfMap["key"].(string); ok && v != "" {
+			apiObject.Key = aws.String(v)
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandPortRangeFilters(tfList []interface{}) []types.PortRangeFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.PortRangeFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.PortRangeFilter{}
+
+		if v, ok := tfMap["begin_inclusive"].(int); ok && v != 0 {
+			apiObject.BeginInclusive = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["end_inclusive"].(int); ok && v != 0 {
+			apiObject.EndInclusive = aws.Int32(int32(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandPackageFilters(tfList []interface{}) []types.PackageFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.PackageFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.PackageFilter{}
+
+		if v, ok := tfMap["architecture"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Architecture = expandStringFilter(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["epoch"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Epoch = expandNumberFilter(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["name"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Name = expandStringFilter(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["release"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Release = expandStringFilter(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["source_lambda_layer_arn"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.SourceLambdaLayerArn = expandStringFilter(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["source_layer_hash"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.SourceLayerHash = expandStringFilter(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["version"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Version = expandStringFilter(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenFilterCriteria(apiObject *types.FilterCriteria) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"aws_account_id":                     flattenStringFilters(apiObject.AwsAccountId),
+		"code_vulnerability_detector_name":   flattenStringFilters(apiObject.CodeVulnerabilityDetectorName),
+		"code_vulnerability_detector_tags":   flattenStringFilters(apiObject.CodeVulnerabilityDetectorTags),
+		"code_vulnerability_file_path":       flattenStringFilters(apiObject.CodeVulnerabilityFilePath),
+		"component_id":                       flattenStringFilters(apiObject.ComponentId),
+		"component_type":                     flattenStringFilters(apiObject.ComponentType),
+		"ec2_instance_image_id":              flattenStringFilters(apiObject.Ec2InstanceImageId),
+		"ec2_instance_subnet_id":             flattenStringFilters(apiObject.Ec2InstanceSubnetId),
+		"ec2_instance_vpc_id":                flattenStringFilters(apiObject.Ec2InstanceVpcId),
+		"ecr_image_architecture":             flattenStringFilters(apiObject.EcrImageArchitecture),
+		"ecr_image_hash":                     flattenStringFilters(apiObject.EcrImageHash),
+		"ecr_image_pushed_at":                flattenDateFilters(apiObject.EcrImagePushedAt),
+		"ecr_image_registry":                 flattenStringFilters(apiObject.EcrImageRegistry),
+		"ecr_image_repository_name":          flattenStringFilters(apiObject.EcrImageRepositoryName),
+		"ecr_image_tags":                     flattenStringFilters(apiObject.EcrImageTags),
+		"epss_score":                         flattenNumberFilters(apiObject.EpssScore),
+		"exploit_available":                  flattenStringFilters(apiObject.ExploitAvailable),
+		"finding_arn":                        flattenStringFilters(apiObject.FindingArn),
+		"finding_status":                     flattenStringFilters(apiObject.FindingStatus),
+		"finding_type":                       flattenStringFilters(apiObject.FindingType),
+		"first_observed_at":                  flattenDateFilters(apiObject.FirstObservedAt),
+		"fix_available":                      flattenStringFilters(apiObject.FixAvailable),
+		"inspector_score":                    flattenNumberFilters(apiObject.InspectorScore),
+		"lambda_function_execution_role_arn": flattenStringFilters(apiObject.LambdaFunctionExecutionRoleArn),
+		"lambda_function_last_modified_at":   flattenDateFilters(apiObject.LambdaFunctionLastModifiedAt),
+		"lambda_function_layers":             flattenStringFilters(apiObject.LambdaFunctionLayers),
+		"lambda_function_name":               flattenStringFilters(apiObject.LambdaFunctionName),
+		"lambda_function_runtime":            flattenStringFilters(apiObject.LambdaFunctionRuntime),
+		"last_observed_at":                   flattenDateFilters(apiObject.LastObservedAt),
+		"network_protocol":                   flattenStringFilters(apiObject.NetworkProtocol),
+		"port_range":                         flattenPortRangeFilters(apiObject.PortRange),
+		"related_vulnerabilities":            flattenStringFilters(apiObject.RelatedVulnerabilities),
+		"resource_id":                        flattenStringFilters(apiObject.ResourceId),
+		"resource_tags":                      flattenMapFilters(apiObject.ResourceTags),
+		"resource_type":                      flattenStringFilters(apiObject.ResourceType),
+		"severity":                           flattenStringFilters(apiObject.Severity),
+		"title":                              flattenStringFilters(apiObject.Title),
+		"updated_at":                         flattenDateFilters(apiObject.UpdatedAt),
+		"vendor_severity":                    flattenStringFilters(apiObject.VendorSeverity),
+		"vulnerability_id":                   flattenStringFilters(apiObject.VulnerabilityId),
+		"vulnerability_source":               flattenStringFilters(apiObject.VulnerabilitySource),
+		"vulnerable_packages":                flattenPackageFilters(apiObject.VulnerablePackages),
+	}
+
+	return tfMap
+}
+
+func flattenStringFilter(apiObject *types.StringFilter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"comparison": string(apiObject.Comparison),
+	}
+
+	if v := apiObject.Value; v != nil {
+		tfMap["value"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenStringFilters(apiObjects []types.StringFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		apiObject := apiObject
+		tfList = append(tfList, flattenStringFilter(&apiObject))
+	}
+
+	return tfList
+}
+
+func flattenNumberFilter(apiObject *types.NumberFilter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LowerInclusive; v != nil {
+		tfMap["lower_inclusive"] = aws.ToFloat64(v)
+	}
+
+	if v := apiObject.UpperInclusive; v != nil {
+		tfMap["upper_inclusive"] = aws.ToFloat64(v)
+	}
+
+	return tfMap
+}
+
+func flattenNumberFilters(apiObjects []types.NumberFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		apiObject := apiObject
+		tfList = append(tfList, flattenNumberFilter(&apiObject))
+	}
+
+	return tfList
+}
+
+func flattenDateFilters(apiObjects []types.DateFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.EndInclusive; v != nil {
+			tfMap["end_inclusive"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.StartInclusive; v != nil {
+			tfMap["start_inclusive"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenMapFilters(apiObjects []types.MapFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"comparison": string(apiObject.Comparison),
+		}
+
+		if v := apiObject.Key; v != nil {
+			tfMap["key"] = aws.ToString(v)
+		}
+
+		if v := apiObject.Value; v != nil {
+			tfMap["value"] = aws.ToString(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenPortRangeFilters(apiObjects []types.PortRangeFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.BeginInclusive; v != nil {
+			tfMap["begin_inclusive"] = aws.ToInt32(v)
+		}
+
+		if v := apiObject.EndInclusive; v != nil {
+			tfMap["end_inclusive"] = aws.ToInt32(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenPackageFilters(apiObjects []types.PackageFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Architecture; v != nil {
+			tfMap["architecture"] = []interface{}{flattenStringFilter(v)}
+		}
+
+		if v := apiObject.Epoch; v != nil {
+			tfMap["epoch"] = []interface{}{flattenNumberFilter(v)}
+		}
+
+		if v := apiObject.Name; v != nil {
+			tfMap["name"] = []interface{}{flattenStringFilter(v)}
+		}
+
+		if v := apiObject.Release; v != nil {
+			tfMap["release"] = []interface{}{flattenStringFilter(v)}
+		}
+
+		if v := apiObject.SourceLambdaLayerArn; v != nil {
+			tfMap["source_lambda_layer_arn"] = []interface{}{flattenStringFilter(v)}
+		}
+
+		if v := apiObject.SourceLayerHash; v != nil {
+			tfMap["source_layer_hash"] = []interface{}{flattenStringFilter(v)}
+		}
+
+		if v := apiObject.Version; v != nil {
+			tfMap["version"] = []interface{}{flattenStringFilter(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -26,10 +26,25 @@ func TestAccInspector2_serial(t *testing.T) {
 			"memberAccount_updateMemberAccountsAndScanTypes": testAccEnabler_memberAccount_updateMemberAccountsAndScanTypes,
 			"memberAccount_disappearsMemberAssociation":      testAccEnabler_memberAccount_disappearsMemberAssociation,
 		},
+		"CISScanConfiguration": {
+			"basic":      testAccCISScanConfiguration_basic,
+			"disappears": testAccCISScanConfiguration_disappears,
+			"update":     testAccCISScanConfiguration_update,
+			"tags":       testAccCISScanConfiguration_tags,
+		},
 		"DelegatedAdminAccount": {
 			"basic":      testAccDelegatedAdminAccount_basic,
 			"disappears": testAccDelegatedAdminAccount_disappears,
 		},
+		"Filter": {
+			"basic":      testAccFilter_basic,
+			"disappears": testAccFilter_disappears,
+			"update":     testAccFilter_update,
+			"tags":       testAccFilter_tags,
+		},
+		"CoverageDataSource": {
+			"basic": testAccCoverageDataSource_basic,
+		},
 		"MemberAssociation": {
 			"basic":      testAccMemberAssociation_basic,
 			"disappears": testAccMemberAssociation_disappears,
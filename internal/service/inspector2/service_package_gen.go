@@ -23,11 +23,25 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceCoverage,
+			TypeName: "aws_inspector2_coverage",
+			Name:     "Coverage",
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceCISScanConfiguration,
+			TypeName: "aws_inspector2_cis_scan_configuration",
+			Name:     "CIS Scan Configuration",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceDelegatedAdminAccount,
 			TypeName: "aws_inspector2_delegated_admin_account",
@@ -36,6 +50,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceEnabler,
 			TypeName: "aws_inspector2_enabler",
 		},
+		{
+			Factory:  ResourceFilter,
+			TypeName: "aws_inspector2_filter",
+			Name:     "Filter",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceMemberAssociation,
 			TypeName: "aws_inspector2_member_association",
@@ -6,6 +6,7 @@ package kms
 import (
 	"context"
 	"encoding/base64"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
@@ -77,17 +78,8 @@ func dataSourceSecretsRead(ctx context.Context, d *schema.ResourceData, meta int
 		secret := v.(map[string]interface{})
 		name := secret["name"].(string)
 
-		// base64 decode the payload
-		payload, err := base64.StdEncoding.DecodeString(secret["payload"].(string))
-
-		if err != nil {
-			return diag.Errorf("invalid base64 value for secret (%s): %s", name, err)
-		}
-
 		// build the kms decrypt input
-		input := &kms.DecryptInput{
-			CiphertextBlob: payload,
-		}
+		input := &kms.DecryptInput{}
 
 		if v, ok := secret["context"].(map[string]interface{}); ok && len(v) > 0 {
 			input.EncryptionContext = flex.ExpandStringMap(v)
@@ -106,14 +98,14 @@ func dataSourceSecretsRead(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		// decrypt
-		output, err := conn.DecryptWithContext(ctx, input)
+		v, err := decryptSecret(ctx, conn, name, secret["payload"].(string), input)
 
 		if err != nil {
-			return diag.Errorf("decrypting secret (%s): %s", name, err)
+			return diag.FromErr(err)
 		}
 
 		// Set the secret via the name
-		plaintext[name] = string(output.Plaintext)
+		plaintext[name] = v
 	}
 
 	d.SetId(meta.(*conns.AWSClient).Region)
@@ -121,3 +113,23 @@ func dataSourceSecretsRead(ctx context.Context, d *schema.ResourceData, meta int
 
 	return nil
 }
+
+// decryptSecret base64 decodes the named secret's payload and decrypts it using the specified input.
+// It is shared by the aws_kms_secrets data source and ephemeral resource.
+func decryptSecret(ctx context.Context, conn *kms.KMS, name, payload string, input *kms.DecryptInput) (string, error) {
+	ciphertext, err := base64.StdEncoding.DecodeString(payload)
+
+	if err != nil {
+		return "", fmt.Errorf("invalid base64 value for secret (%s): %w", name, err)
+	}
+
+	input.CiphertextBlob = ciphertext
+
+	output, err := conn.DecryptWithContext(ctx, input)
+
+	if err != nil {
+		return "", fmt.Errorf("decrypting secret (%s): %w", name, err)
+	}
+
+	return string(output.Plaintext), nil
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+// @EphemeralResource(name="Secrets")
+func newEphemeralSecrets(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralSecrets{}, nil
+}
+
+type ephemeralSecrets struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralSecrets) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_kms_secrets"
+}
+
+func (e *ephemeralSecrets) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"plaintext": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"secret": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"context": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"encryption_algorithm": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(kms.EncryptionAlgorithmSpec_Values()...),
+							},
+						},
+						"grant_tokens": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"key_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+						"payload": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *ephemeralSecrets) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data ephemeralSecretsData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().KMSConn(ctx)
+
+	var secrets []ephemeralSecretData
+
+	response.Diagnostics.Append(data.Secrets.ElementsAs(ctx, &secrets, false)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	plaintext := make(map[string]string, len(secrets))
+
+	for _, secret := range secrets {
+		name := secret.Name.ValueString()
+		input := &kms.DecryptInput{
+			EncryptionAlgorithm: flex.StringFromFramework(ctx, secret.EncryptionAlgorithm),
+			KeyId:               flex.StringFromFramework(ctx, secret.KeyID),
+		}
+
+		if v := flex.ExpandFrameworkStringValueMap(ctx, secret.Context); len(v) > 0 {
+			input.EncryptionContext = aws.StringMap(v)
+		}
+
+		if v := flex.ExpandFrameworkStringList(ctx, secret.GrantTokens); len(v) > 0 {
+			input.GrantTokens = v
+		}
+
+		v, err := decryptSecret(ctx, conn, name, secret.Payload.ValueString(), input)
+
+		if err != nil {
+			response.Diagnostics.AddError("opening KMS Secrets", err.Error())
+
+			return
+		}
+
+		plaintext[name] = v
+	}
+
+	data.Plaintext = flex.FlattenFrameworkStringValueMapLegacy(ctx, plaintext)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type ephemeralSecretsData struct {
+	Plaintext types.Map `tfsdk:"plaintext"`
+	Secrets   types.Set `tfsdk:"secret"`
+}
+
+type ephemeralSecretData struct {
+	Context             types.Map    `tfsdk:"context"`
+	EncryptionAlgorithm types.String `tfsdk:"encryption_algorithm"`
+	GrantTokens         types.List   `tfsdk:"grant_tokens"`
+	KeyID               types.String `tfsdk:"key_id"`
+	Name                types.String `tfsdk:"name"`
+	Payload             types.String `tfsdk:"payload"`
+}
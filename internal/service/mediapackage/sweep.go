@@ -18,7 +18,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_media_package_channel", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_media_package_channel", &resource.Sweeper{
 		Name: "aws_media_package_channel",
 		F:    sweepChannels,
 	})
@@ -24,3 +24,8 @@ func (p *servicePackage) CustomizeConn(ctx context.Context, conn *organizations_
 
 	return conn, nil
 }
+
+// IsGlobal returns true as Organizations resources are not Regional.
+func (p *servicePackage) IsGlobal() bool {
+	return true
+}
@@ -24,3 +24,8 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 		}
 	}), nil
 }
+
+// IsGlobal returns true as Route 53 Domains resources are not Regional.
+func (p *servicePackage) IsGlobal() bool {
+	return true
+}
@@ -24,3 +24,8 @@ func (p *servicePackage) NewConn(ctx context.Context, m map[string]any) (*route5
 
 	return route53recoverycontrolconfig_sdkv1.New(sess.Copy(config)), nil
 }
+
+// IsGlobal returns true as Route 53 Recovery Control Config resources are not Regional.
+func (p *servicePackage) IsGlobal() bool {
+	return true
+}
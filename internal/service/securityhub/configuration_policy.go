@@ -0,0 +1,515 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_securityhub_configuration_policy", name="Configuration Policy")
+// @Tags(identifierAttribute="arn")
+func ResourceConfigurationPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfigurationPolicyCreate,
+		ReadWithoutTimeout:   resourceConfigurationPolicyRead,
+		UpdateWithoutTimeout: resourceConfigurationPolicyUpdate,
+		DeleteWithoutTimeout: resourceConfigurationPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled_standard_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+						"security_controls_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"disabled_control_identifiers": {
+										Type:          schema.TypeSet,
+										Optional:      true,
+										Elem:          &schema.Schema{Type: schema.TypeString},
+										ConflictsWith: []string{"configuration_policy.0.security_controls_configuration.0.enabled_control_identifiers"},
+									},
+									"enabled_control_identifiers": {
+										Type:          schema.TypeSet,
+										Optional:      true,
+										Elem:          &schema.Schema{Type: schema.TypeString},
+										ConflictsWith: []string{"configuration_policy.0.security_controls_configuration.0.disabled_control_identifiers"},
+									},
+									"security_control_custom_parameter": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"parameter": {
+													Type:     schema.TypeSet,
+													Required: true,
+													MinItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"bool":      parameterValueSchema(schema.TypeBool),
+															"double":    parameterValueSchema(schema.TypeFloat),
+															"enum":      parameterValueSchema(schema.TypeString),
+															"enum_list": parameterListValueSchema(schema.TypeString),
+															"int":       parameterValueSchema(schema.TypeInt),
+															"int_list":  parameterListValueSchema(schema.TypeInt),
+															"name": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"string":      parameterValueSchema(schema.TypeString),
+															"string_list": parameterListValueSchema(schema.TypeString),
+															"value_type": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(securityhub.ParameterValueType_Values(), false),
+															},
+														},
+													},
+												},
+												"security_control_id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"service_enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func parameterValueSchema(valueType schema.ValueType) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"value": {
+					Type:     valueType,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func parameterListValueSchema(valueType schema.ValueType) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"value": {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem:     &schema.Schema{Type: valueType},
+				},
+			},
+		},
+	}
+}
+
+func resourceConfigurationPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &securityhub.CreateConfigurationPolicyInput{
+		ConfigurationPolicy: expandPolicy(d.Get("configuration_policy").([]interface{})),
+		Name:                aws.String(name),
+		Tags:                getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateConfigurationPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Security Hub Configuration Policy (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return append(diags, resourceConfigurationPolicyRead(ctx, d, meta)...)
+}
+
+func resourceConfigurationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	output, err := FindConfigurationPolicyByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Hub Configuration Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Hub Configuration Policy (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	if err := d.Set("configuration_policy", flattenPolicy(output.ConfigurationPolicy)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration_policy: %s", err)
+	}
+	d.Set("description", output.Description)
+	d.Set("name", output.Name)
+
+	return diags
+}
+
+func resourceConfigurationPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &securityhub.UpdateConfigurationPolicyInput{
+			ConfigurationPolicy: expandPolicy(d.Get("configuration_policy").([]interface{})),
+			Description:         aws.String(d.Get("description").(string)),
+			Identifier:          aws.String(d.Id()),
+			Name:                aws.String(d.Get("name").(string)),
+		}
+
+		_, err := conn.UpdateConfigurationPolicyWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Security Hub Configuration Policy (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceConfigurationPolicyRead(ctx, d, meta)...)
+}
+
+func resourceConfigurationPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Security Hub Configuration Policy: %s", d.Id())
+	_, err := conn.DeleteConfigurationPolicyWithContext(ctx, &securityhub.DeleteConfigurationPolicyInput{
+		Identifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Security Hub Configuration Policy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindConfigurationPolicyByID(ctx context.Context, conn *securityhub.SecurityHub, id string) (*securityhub.GetConfigurationPolicyOutput, error) {
+	input := &securityhub.GetConfigurationPolicyInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetConfigurationPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandPolicy(tfList []interface{}) *securityhub.Policy {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &securityhub.SecurityHubPolicy{
+		ServiceEnabled: aws.Bool(tfMap["service_enabled"].(bool)),
+	}
+
+	// Send an empty list rather than omitting it so that removing every standard from the configuration disables them.
+	if v, ok := tfMap["enabled_standard_arns"].(*schema.Set); ok {
+		apiObject.EnabledStandardIdentifiers = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["security_controls_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SecurityControlsConfiguration = expandSecurityControlsConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return &securityhub.Policy{
+		SecurityHub: apiObject,
+	}
+}
+
+func expandSecurityControlsConfiguration(tfMap map[string]interface{}) *securityhub.SecurityControlsConfiguration {
+	apiObject := &securityhub.SecurityControlsConfiguration{}
+
+	if v, ok := tfMap["disabled_control_identifiers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.DisabledSecurityControlIdentifiers = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["enabled_control_identifiers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EnabledSecurityControlIdentifiers = flex.ExpandStringSet(v)
+	}
+
+	// Exactly one of the identifier lists must be present; an empty disabled list means every control is enabled.
+	if apiObject.DisabledSecurityControlIdentifiers == nil && apiObject.EnabledSecurityControlIdentifiers == nil {
+		apiObject.DisabledSecurityControlIdentifiers = []*string{}
+	}
+
+	if v, ok := tfMap["security_control_custom_parameter"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityControlCustomParameters = expandSecurityControlCustomParameters(v.List())
+	}
+
+	return apiObject
+}
+
+func expandSecurityControlCustomParameters(tfList []interface{}) []*securityhub.SecurityControlCustomParameter {
+	var apiObjects []*securityhub.SecurityControlCustomParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &securityhub.SecurityControlCustomParameter{
+			SecurityControlId: aws.String(tfMap["security_control_id"].(string)),
+		}
+
+		if v, ok := tfMap["parameter"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Parameters = expandParameterConfigurations(v.List())
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandParameterConfigurations(tfList []interface{}) map[string]*securityhub.ParameterConfiguration {
+	apiObjects := make(map[string]*securityhub.ParameterConfiguration)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &securityhub.ParameterConfiguration{
+			ValueType: aws.String(tfMap["value_type"].(string)),
+		}
+
+		if v := expandParameterValue(tfMap); v != nil {
+			apiObject.Value = v
+		}
+
+		apiObjects[tfMap["name"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandParameterValue(tfMap map[string]interface{}) *securityhub.ParameterValue {
+	value := func(k string) (interface{}, bool) {
+		if v, ok := tfMap[k].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			return v[0].(map[string]interface{})["value"], true
+		}
+
+		return nil, false
+	}
+
+	if v, ok := value("bool"); ok {
+		return &securityhub.ParameterValue{Boolean: aws.Bool(v.(bool))}
+	}
+
+	if v, ok := value("double"); ok {
+		return &securityhub.ParameterValue{Double: aws.Float64(v.(float64))}
+	}
+
+	if v, ok := value("enum"); ok {
+		return &securityhub.ParameterValue{Enum: aws.String(v.(string))}
+	}
+
+	if v, ok := value("enum_list"); ok {
+		return &securityhub.ParameterValue{EnumList: flex.ExpandStringList(v.([]interface{}))}
+	}
+
+	if v, ok := value("int"); ok {
+		return &securityhub.ParameterValue{Integer: aws.Int64(int64(v.(int)))}
+	}
+
+	if v, ok := value("int_list"); ok {
+		return &securityhub.ParameterValue{IntegerList: flex.ExpandInt64List(v.([]interface{}))}
+	}
+
+	if v, ok := value("string"); ok {
+		return &securityhub.ParameterValue{String_: aws.String(v.(string))}
+	}
+
+	if v, ok := value("string_list"); ok {
+		return &securityhub.ParameterValue{StringList: flex.ExpandStringList(v.([]interface{}))}
+	}
+
+	return nil
+}
+
+func flattenPolicy(apiObject *securityhub.Policy) []interface{} {
+	if apiObject == nil || apiObject.SecurityHub == nil {
+		return nil
+	}
+
+	policy := apiObject.SecurityHub
+	tfMap := map[string]interface{}{
+		"enabled_standard_arns": aws.StringValueSlice(policy.EnabledStandardIdentifiers),
+		"service_enabled":       aws.BoolValue(policy.ServiceEnabled),
+	}
+
+	if v := policy.SecurityControlsConfiguration; v != nil {
+		tfMap["security_controls_configuration"] = []interface{}{flattenSecurityControlsConfiguration(v)}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSecurityControlsConfiguration(apiObject *securityhub.SecurityControlsConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"disabled_control_identifiers": aws.StringValueSlice(apiObject.DisabledSecurityControlIdentifiers),
+		"enabled_control_identifiers":  aws.StringValueSlice(apiObject.EnabledSecurityControlIdentifiers),
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObject.SecurityControlCustomParameters {
+		if apiObject == nil {
+			continue
+		}
+
+		var parameters []interface{}
+
+		for name, parameter := range apiObject.Parameters {
+			if parameter == nil {
+				continue
+			}
+
+			parameters = append(parameters, flattenParameterConfiguration(name, parameter))
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"parameter":           parameters,
+			"security_control_id": aws.StringValue(apiObject.SecurityControlId),
+		})
+	}
+
+	tfMap["security_control_custom_parameter"] = tfList
+
+	return tfMap
+}
+
+func flattenParameterConfiguration(name string, apiObject *securityhub.ParameterConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"name":       name,
+		"value_type": aws.StringValue(apiObject.ValueType),
+	}
+
+	v := apiObject.Value
+
+	if v == nil {
+		return tfMap
+	}
+
+	switch {
+	case v.Boolean != nil:
+		tfMap["bool"] = []interface{}{map[string]interface{}{"value": aws.BoolValue(v.Boolean)}}
+	case v.Double != nil:
+		tfMap["double"] = []interface{}{map[string]interface{}{"value": aws.Float64Value(v.Double)}}
+	case v.Enum != nil:
+		tfMap["enum"] = []interface{}{map[string]interface{}{"value": aws.StringValue(v.Enum)}}
+	case v.EnumList != nil:
+		tfMap["enum_list"] = []interface{}{map[string]interface{}{"value": aws.StringValueSlice(v.EnumList)}}
+	case v.Integer != nil:
+		tfMap["int"] = []interface{}{map[string]interface{}{"value": aws.Int64Value(v.Integer)}}
+	case v.IntegerList != nil:
+		tfMap["int_list"] = []interface{}{map[string]interface{}{"value": flex.FlattenInt64List(v.IntegerList)}}
+	case v.String_ != nil:
+		tfMap["string"] = []interface{}{map[string]interface{}{"value": aws.StringValue(v.String_)}}
+	case v.StringList != nil:
+		tfMap["string_list"] = []interface{}{map[string]interface{}{"value": aws.StringValueSlice(v.StringList)}}
+	}
+
+	return tfMap
+}
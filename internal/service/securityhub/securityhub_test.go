@@ -20,6 +20,22 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			"MigrateV0":                   testAccAccount_migrateV0,
 			"full":                        testAccAccount_full,
 		},
+		"AutomationRule": {
+			"basic":      testAccAutomationRule_basic,
+			"disappears": testAccAutomationRule_disappears,
+			"full":       testAccAutomationRule_full,
+			"tags":       testAccAutomationRule_tags,
+		},
+		"ConfigurationPolicy": {
+			"basic":                 testAccConfigurationPolicy_basic,
+			"disappears":            testAccConfigurationPolicy_disappears,
+			"controlsConfiguration": testAccConfigurationPolicy_controlsConfiguration,
+			"tags":                  testAccConfigurationPolicy_tags,
+		},
+		"ConfigurationPolicyAssociation": {
+			"basic":      testAccConfigurationPolicyAssociation_basic,
+			"disappears": testAccConfigurationPolicyAssociation_disappears,
+		},
 		"Member": {
 			"basic":  testAccMember_basic,
 			"invite": testAccMember_invite,
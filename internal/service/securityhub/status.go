@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -35,3 +36,19 @@ func statusAdminAccountAdmin(ctx context.Context, conn *securityhub.SecurityHub,
 		return adminAccount, aws.StringValue(adminAccount.Status), nil
 	}
 }
+
+func statusConfigurationPolicyAssociation(ctx context.Context, conn *securityhub.SecurityHub, targetID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindConfigurationPolicyAssociationByTargetID(ctx, conn, targetID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.AssociationStatus), nil
+	}
+}
@@ -5,10 +5,13 @@ package securityhub
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -54,3 +57,45 @@ func waitAdminAccountNotFound(ctx context.Context, conn *securityhub.SecurityHub
 
 	return nil, err
 }
+
+func waitConfigurationPolicyAssociationSucceeded(ctx context.Context, conn *securityhub.SecurityHub, targetID string, timeout time.Duration) (*securityhub.GetConfigurationPolicyAssociationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{securityhub.ConfigurationPolicyAssociationStatusPending},
+		Target:  []string{securityhub.ConfigurationPolicyAssociationStatusSuccess},
+		Refresh: statusConfigurationPolicyAssociation(ctx, conn, targetID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*securityhub.GetConfigurationPolicyAssociationOutput); ok {
+		if status := aws.StringValue(output.AssociationStatus); status == securityhub.ConfigurationPolicyAssociationStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.AssociationStatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitConfigurationPolicyAssociationDeleted(ctx context.Context, conn *securityhub.SecurityHub, targetID string, timeout time.Duration) (*securityhub.GetConfigurationPolicyAssociationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{securityhub.ConfigurationPolicyAssociationStatusPending, securityhub.ConfigurationPolicyAssociationStatusSuccess},
+		Target:  []string{},
+		Refresh: statusConfigurationPolicyAssociation(ctx, conn, targetID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*securityhub.GetConfigurationPolicyAssociationOutput); ok {
+		if status := aws.StringValue(output.AssociationStatus); status == securityhub.ConfigurationPolicyAssociationStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.AssociationStatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,406 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="AWS Log Source")
+func newResourceAWSLogSource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceAWSLogSource{}, nil
+}
+
+type resourceAWSLogSource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceAWSLogSource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_aws_log_source"
+}
+
+func (r *resourceAWSLogSource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"source": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"accounts": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.RequiresReplace(),
+								setplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"regions": schema.SetAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.RequiresReplace(),
+							},
+						},
+						"source_name": schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.String{
+								enum.FrameworkValidate[awstypes.AwsLogSourceName](),
+							},
+						},
+						"source_version": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceAWSLogSource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceAWSLogSourceData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	logSource, diags := expandAWSLogSourceConfiguration(ctx, data.Source)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &securitylake.CreateAwsLogSourceInput{
+		Sources: []awstypes.AwsLogSourceConfiguration{*logSource},
+	}
+
+	// The data lake may still be settling after creation or update.
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateAwsLogSource(ctx, input)
+	})
+
+	if err == nil {
+		if v := outputRaw.(*securitylake.CreateAwsLogSourceOutput).Failed; len(v) > 0 {
+			err = fmt.Errorf("failed accounts: %s", strings.Join(v, ", "))
+		}
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Lake AWS Log Source (%s)", logSource.SourceName), err.Error())
+
+		return
+	}
+
+	data.ID = flex.StringValueToFramework(ctx, logSource.SourceName)
+
+	outputRaw, err = tfresource.RetryWhenNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		return findAWSLogSourceBySourceName(ctx, conn, logSource.SourceName)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake AWS Log Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	source, diags := flattenAWSLogSourceConfiguration(ctx, outputRaw.(*awstypes.AwsLogSourceConfiguration))
+	response.Diagnostics.Append(diags...)
+	data.Source = source
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceAWSLogSource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceAWSLogSourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	logSource, err := findAWSLogSourceBySourceName(ctx, conn, awstypes.AwsLogSourceName(data.ID.ValueString()))
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake AWS Log Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	source, diags := flattenAWSLogSourceConfiguration(ctx, logSource)
+	response.Diagnostics.Append(diags...)
+	data.Source = source
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceAWSLogSource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data resourceAWSLogSourceData
+
+	// All attributes force replacement.
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceAWSLogSource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceAWSLogSourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	logSource, diags := expandAWSLogSourceConfiguration(ctx, data.Source)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if logSource == nil {
+		var err error
+		logSource, err = findAWSLogSourceBySourceName(ctx, conn, awstypes.AwsLogSourceName(data.ID.ValueString()))
+
+		if tfresource.NotFound(err) {
+			return
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake AWS Log Source (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "deleting Security Lake AWS Log Source", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	output, err := conn.DeleteAwsLogSource(ctx, &securitylake.DeleteAwsLogSourceInput{
+		Sources: []awstypes.AwsLogSourceConfiguration{*logSource},
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err == nil && len(output.Failed) > 0 {
+		err = fmt.Errorf("failed accounts: %s", strings.Join(output.Failed, ", "))
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake AWS Log Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if err := waitAWSLogSourceDeleted(ctx, conn, logSource.SourceName, propagationTimeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake AWS Log Source (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type resourceAWSLogSourceData struct {
+	ID     types.String `tfsdk:"id"`
+	Source types.List   `tfsdk:"source"`
+}
+
+type awsLogSourceConfigurationData struct {
+	Accounts      types.Set    `tfsdk:"accounts"`
+	Regions       types.Set    `tfsdk:"regions"`
+	SourceName    types.String `tfsdk:"source_name"`
+	SourceVersion types.String `tfsdk:"source_version"`
+}
+
+var awsLogSourceConfigurationAttrTypes = map[string]attr.Type{
+	"accounts":       types.SetType{ElemType: types.StringType},
+	"regions":        types.SetType{ElemType: types.StringType},
+	"source_name":    types.StringType,
+	"source_version": types.StringType,
+}
+
+func expandAWSLogSourceConfiguration(ctx context.Context, tfList types.List) (*awstypes.AwsLogSourceConfiguration, diag.Diagnostics) {
+	var data []awsLogSourceConfigurationData
+	diags := tfList.ElementsAs(ctx, &data, false)
+
+	if diags.HasError() || len(data) == 0 {
+		return nil, diags
+	}
+
+	apiObject := &awstypes.AwsLogSourceConfiguration{
+		Regions:    flex.ExpandFrameworkStringValueSet(ctx, data[0].Regions),
+		SourceName: awstypes.AwsLogSourceName(data[0].SourceName.ValueString()),
+	}
+
+	if !data[0].Accounts.IsUnknown() {
+		apiObject.Accounts = flex.ExpandFrameworkStringValueSet(ctx, data[0].Accounts)
+	}
+
+	if !data[0].SourceVersion.IsUnknown() {
+		apiObject.SourceVersion = flex.StringFromFramework(ctx, data[0].SourceVersion)
+	}
+
+	return apiObject, diags
+}
+
+func flattenAWSLogSourceConfiguration(ctx context.Context, apiObject *awstypes.AwsLogSourceConfiguration) (types.List, diag.Diagnostics) {
+	elemType := types.ObjectType{AttrTypes: awsLogSourceConfigurationAttrTypes}
+
+	if apiObject == nil {
+		return types.ListValueMust(elemType, []attr.Value{}), nil
+	}
+
+	return types.ListValueFrom(ctx, elemType, []awsLogSourceConfigurationData{{
+		Accounts:      flex.FlattenFrameworkStringValueSet(ctx, apiObject.Accounts),
+		Regions:       flex.FlattenFrameworkStringValueSet(ctx, apiObject.Regions),
+		SourceName:    flex.StringValueToFramework(ctx, apiObject.SourceName),
+		SourceVersion: flex.StringToFramework(ctx, apiObject.SourceVersion),
+	}})
+}
+
+// findAWSLogSourceBySourceName returns the accounts and Regions from which the named AWS log source is collected.
+func findAWSLogSourceBySourceName(ctx context.Context, conn *securitylake.Client, sourceName awstypes.AwsLogSourceName) (*awstypes.AwsLogSourceConfiguration, error) {
+	input := &securitylake.ListLogSourcesInput{}
+	var output *awstypes.AwsLogSourceConfiguration
+	accounts, regions := make(map[string]struct{}), make(map[string]struct{})
+
+	pages := securitylake.NewListLogSourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, logSource := range page.Sources {
+			for _, v := range logSource.Sources {
+				v, ok := v.(*awstypes.LogSourceResourceMemberAwsLogSource)
+
+				if !ok || v.Value.SourceName != sourceName {
+					continue
+				}
+
+				if output == nil {
+					output = &awstypes.AwsLogSourceConfiguration{
+						SourceName:    v.Value.SourceName,
+						SourceVersion: v.Value.SourceVersion,
+					}
+				}
+
+				if account := aws.ToString(logSource.Account); account != "" {
+					if _, ok := accounts[account]; !ok {
+						accounts[account] = struct{}{}
+						output.Accounts = append(output.Accounts, account)
+					}
+				}
+
+				if region := aws.ToString(logSource.Region); region != "" {
+					if _, ok := regions[region]; !ok {
+						regions[region] = struct{}{}
+						output.Regions = append(output.Regions, region)
+					}
+				}
+			}
+		}
+	}
+
+	if output == nil {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func waitAWSLogSourceDeleted(ctx context.Context, conn *securitylake.Client, sourceName awstypes.AwsLogSourceName, timeout time.Duration) error {
+	return tfresource.WaitUntil(ctx, timeout, func() (bool, error) {
+		_, err := findAWSLogSourceBySourceName(ctx, conn, sourceName)
+
+		if tfresource.NotFound(err) {
+			return true, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return false, nil
+	}, tfresource.WaitOpts{
+		PollInterval: 10 * time.Second,
+	})
+}
@@ -0,0 +1,484 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Custom Log Source")
+func newResourceCustomLogSource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceCustomLogSource{}, nil
+}
+
+type resourceCustomLogSource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceCustomLogSource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_custom_log_source"
+}
+
+func (r *resourceCustomLogSource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"attributes": schema.ListAttribute{
+				ElementType: types.ObjectType{AttrTypes: customLogSourceAttributesAttrTypes},
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"event_classes": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"provider_details": schema.ListAttribute{
+				ElementType: types.ObjectType{AttrTypes: customLogSourceProviderAttrTypes},
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"source_version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"configuration": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"crawler_configuration": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"role_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+						},
+						"provider_identity": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"external_id": schema.StringAttribute{
+										Required: true,
+									},
+									"principal": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceCustomLogSource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceCustomLogSourceData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	configuration, diags := expandCustomLogSourceConfiguration(ctx, data.Configuration)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &securitylake.CreateCustomLogSourceInput{
+		Configuration: configuration,
+		EventClasses:  flex.ExpandFrameworkStringValueSet(ctx, data.EventClasses),
+		SourceName:    flex.StringFromFramework(ctx, data.SourceName),
+	}
+
+	if !data.SourceVersion.IsUnknown() {
+		input.SourceVersion = flex.StringFromFramework(ctx, data.SourceVersion)
+	}
+
+	// The data lake may still be settling after creation or update.
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateCustomLogSource(ctx, input)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Lake Custom Log Source (%s)", data.SourceName.ValueString()), err.Error())
+
+		return
+	}
+
+	output := outputRaw.(*securitylake.CreateCustomLogSourceOutput)
+
+	if output.Source == nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Lake Custom Log Source (%s)", data.SourceName.ValueString()), tfresource.NewEmptyResultError(input).Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = flex.StringToFramework(ctx, output.Source.SourceName)
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, output.Source)...)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceCustomLogSource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceCustomLogSourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	customLogSource, err := findCustomLogSourceBySourceName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Custom Log Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.SourceName = flex.StringToFramework(ctx, customLogSource.SourceName)
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, customLogSource)...)
+
+	if data.Configuration.IsNull() {
+		data.Configuration = types.ListValueMust(types.ObjectType{AttrTypes: customLogSourceConfigurationAttrTypes}, []attr.Value{})
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceCustomLogSource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data resourceCustomLogSourceData
+
+	// All attributes force replacement.
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceCustomLogSource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceCustomLogSourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	tflog.Debug(ctx, "deleting Security Lake Custom Log Source", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeleteCustomLogSource(ctx, &securitylake.DeleteCustomLogSourceInput{
+		SourceName:    flex.StringFromFramework(ctx, data.ID),
+		SourceVersion: flex.StringFromFramework(ctx, data.SourceVersion),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Custom Log Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if err := waitCustomLogSourceDeleted(ctx, conn, data.ID.ValueString(), propagationTimeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Custom Log Source (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type resourceCustomLogSourceData struct {
+	Attributes      types.List   `tfsdk:"attributes"`
+	Configuration   types.List   `tfsdk:"configuration"`
+	EventClasses    types.Set    `tfsdk:"event_classes"`
+	ID              types.String `tfsdk:"id"`
+	ProviderDetails types.List   `tfsdk:"provider_details"`
+	SourceName      types.String `tfsdk:"source_name"`
+	SourceVersion   types.String `tfsdk:"source_version"`
+}
+
+type customLogSourceConfigurationData struct {
+	CrawlerConfiguration types.List `tfsdk:"crawler_configuration"`
+	ProviderIdentity     types.List `tfsdk:"provider_identity"`
+}
+
+type customLogSourceCrawlerConfigurationData struct {
+	RoleARN fwtypes.ARN `tfsdk:"role_arn"`
+}
+
+type awsIdentityData struct {
+	ExternalID types.String `tfsdk:"external_id"`
+	Principal  types.String `tfsdk:"principal"`
+}
+
+type customLogSourceAttributesData struct {
+	CrawlerARN  types.String `tfsdk:"crawler_arn"`
+	DatabaseARN types.String `tfsdk:"database_arn"`
+	TableARN    types.String `tfsdk:"table_arn"`
+}
+
+type customLogSourceProviderData struct {
+	Location types.String `tfsdk:"location"`
+	RoleARN  types.String `tfsdk:"role_arn"`
+}
+
+var (
+	awsIdentityAttrTypes = map[string]attr.Type{
+		"external_id": types.StringType,
+		"principal":   types.StringType,
+	}
+
+	customLogSourceAttributesAttrTypes = map[string]attr.Type{
+		"crawler_arn":  types.StringType,
+		"database_arn": types.StringType,
+		"table_arn":    types.StringType,
+	}
+
+	customLogSourceConfigurationAttrTypes = map[string]attr.Type{
+		"crawler_configuration": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+			"role_arn": fwtypes.ARNType,
+		}}},
+		"provider_identity": types.ListType{ElemType: types.ObjectType{AttrTypes: awsIdentityAttrTypes}},
+	}
+
+	customLogSourceProviderAttrTypes = map[string]attr.Type{
+		"location": types.StringType,
+		"role_arn": types.StringType,
+	}
+)
+
+func (data *resourceCustomLogSourceData) refreshFromOutput(ctx context.Context, apiObject *awstypes.CustomLogSourceResource) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	attributes, d := flattenCustomLogSourceAttributes(ctx, apiObject.Attributes)
+	diags.Append(d...)
+	data.Attributes = attributes
+
+	providerDetails, d := flattenCustomLogSourceProvider(ctx, apiObject.Provider)
+	diags.Append(d...)
+	data.ProviderDetails = providerDetails
+
+	data.SourceVersion = flex.StringToFramework(ctx, apiObject.SourceVersion)
+
+	return diags
+}
+
+func expandCustomLogSourceConfiguration(ctx context.Context, tfList types.List) (*awstypes.CustomLogSourceConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var data []customLogSourceConfigurationData
+
+	diags.Append(tfList.ElementsAs(ctx, &data, false)...)
+
+	if diags.HasError() || len(data) == 0 {
+		return nil, diags
+	}
+
+	apiObject := &awstypes.CustomLogSourceConfiguration{}
+
+	var crawlerConfigurations []customLogSourceCrawlerConfigurationData
+	diags.Append(data[0].CrawlerConfiguration.ElementsAs(ctx, &crawlerConfigurations, false)...)
+
+	if len(crawlerConfigurations) > 0 {
+		apiObject.CrawlerConfiguration = &awstypes.CustomLogSourceCrawlerConfiguration{
+			RoleArn: flex.ARNStringFromFramework(ctx, crawlerConfigurations[0].RoleARN),
+		}
+	}
+
+	var providerIdentities []awsIdentityData
+	diags.Append(data[0].ProviderIdentity.ElementsAs(ctx, &providerIdentities, false)...)
+
+	if len(providerIdentities) > 0 {
+		apiObject.ProviderIdentity = expandAWSIdentity(ctx, providerIdentities[0])
+	}
+
+	return apiObject, diags
+}
+
+func expandAWSIdentity(ctx context.Context, data awsIdentityData) *awstypes.AwsIdentity {
+	return &awstypes.AwsIdentity{
+		ExternalId: flex.StringFromFramework(ctx, data.ExternalID),
+		Principal:  flex.StringFromFramework(ctx, data.Principal),
+	}
+}
+
+func flattenAWSIdentity(ctx context.Context, apiObject *awstypes.AwsIdentity) (types.List, diag.Diagnostics) {
+	elemType := types.ObjectType{AttrTypes: awsIdentityAttrTypes}
+
+	if apiObject == nil {
+		return types.ListValueMust(elemType, []attr.Value{}), nil
+	}
+
+	return types.ListValueFrom(ctx, elemType, []awsIdentityData{{
+		ExternalID: flex.StringToFramework(ctx, apiObject.ExternalId),
+		Principal:  flex.StringToFramework(ctx, apiObject.Principal),
+	}})
+}
+
+func flattenCustomLogSourceAttributes(ctx context.Context, apiObject *awstypes.CustomLogSourceAttributes) (types.List, diag.Diagnostics) {
+	elemType := types.ObjectType{AttrTypes: customLogSourceAttributesAttrTypes}
+
+	if apiObject == nil {
+		return types.ListValueMust(elemType, []attr.Value{}), nil
+	}
+
+	return types.ListValueFrom(ctx, elemType, []customLogSourceAttributesData{{
+		CrawlerARN:  flex.StringToFramework(ctx, apiObject.CrawlerArn),
+		DatabaseARN: flex.StringToFramework(ctx, apiObject.DatabaseArn),
+		TableARN:    flex.StringToFramework(ctx, apiObject.TableArn),
+	}})
+}
+
+func flattenCustomLogSourceProvider(ctx context.Context, apiObject *awstypes.CustomLogSourceProvider) (types.List, diag.Diagnostics) {
+	elemType := types.ObjectType{AttrTypes: customLogSourceProviderAttrTypes}
+
+	if apiObject == nil {
+		return types.ListValueMust(elemType, []attr.Value{}), nil
+	}
+
+	return types.ListValueFrom(ctx, elemType, []customLogSourceProviderData{{
+		Location: flex.StringToFramework(ctx, apiObject.Location),
+		RoleARN:  flex.StringToFramework(ctx, apiObject.RoleArn),
+	}})
+}
+
+func findCustomLogSourceBySourceName(ctx context.Context, conn *securitylake.Client, sourceName string) (*awstypes.CustomLogSourceResource, error) {
+	input := &securitylake.ListLogSourcesInput{}
+
+	pages := securitylake.NewListLogSourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, logSource := range page.Sources {
+			for _, v := range logSource.Sources {
+				if v, ok := v.(*awstypes.LogSourceResourceMemberCustomLogSource); ok && aws.ToString(v.Value.SourceName) == sourceName {
+					return &v.Value, nil
+				}
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func waitCustomLogSourceDeleted(ctx context.Context, conn *securitylake.Client, sourceName string, timeout time.Duration) error {
+	return tfresource.WaitUntil(ctx, timeout, func() (bool, error) {
+		_, err := findCustomLogSourceBySourceName(ctx, conn, sourceName)
+
+		if tfresource.NotFound(err) {
+			return true, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return false, nil
+	}, tfresource.WaitOpts{
+		PollInterval: 10 * time.Second,
+	})
+}
@@ -0,0 +1,750 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// s3ManagedKMSKeyID is the KMS key ID reported when the data lake's S3 bucket uses S3-managed encryption.
+	s3ManagedKMSKeyID = "S3_MANAGED_KEY"
+)
+
+// @FrameworkResource(name="Data Lake")
+// @Tags(identifierAttribute="arn")
+func newResourceDataLake(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceDataLake{}
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type resourceDataLake struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceDataLake) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_data_lake"
+}
+
+func (r *resourceDataLake) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			"meta_store_manager_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"s3_bucket_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"configuration": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"region": schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"encryption_configuration": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"kms_key_id": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+						"lifecycle_configuration": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"expiration": schema.ListNestedBlock{
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"days": schema.Int64Attribute{
+													Required: true,
+												},
+											},
+										},
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+									},
+									"transition": schema.SetNestedBlock{
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"days": schema.Int64Attribute{
+													Required: true,
+												},
+												"storage_class": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+						"replication_configuration": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"regions": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"role_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Optional:   true,
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceDataLake) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceDataLakeData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	configurations, diags := expandDataLakeConfigurations(ctx, data.Configurations)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &securitylake.CreateDataLakeInput{
+		Configurations:          configurations,
+		MetaStoreManagerRoleArn: flex.ARNStringFromFramework(ctx, data.MetaStoreManagerRoleARN),
+		Tags:                    getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateDataLake(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Security Lake Data Lake", err.Error())
+
+		return
+	}
+
+	if len(output.DataLakes) == 0 {
+		response.Diagnostics.AddError("creating Security Lake Data Lake", tfresource.NewEmptyResultError(input).Error())
+
+		return
+	}
+
+	data.ID = flex.StringToFramework(ctx, output.DataLakes[0].DataLakeArn)
+
+	dataLake, err := waitDataLakeCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Data Lake (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = flex.StringToFramework(ctx, dataLake.DataLakeArn)
+	data.S3BucketARN = flex.StringToFramework(ctx, dataLake.S3BucketArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDataLake) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceDataLakeData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	dataLake, err := findDataLakeByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Data Lake (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ARN = flex.StringToFramework(ctx, dataLake.DataLakeArn)
+	configurations, diags := flattenDataLakeConfigurations(ctx, dataLake)
+	response.Diagnostics.Append(diags...)
+	data.Configurations = configurations
+	data.S3BucketARN = flex.StringToFramework(ctx, dataLake.S3BucketArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDataLake) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceDataLakeData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	if !new.Configurations.Equal(old.Configurations) {
+		configurations, diags := expandDataLakeConfigurations(ctx, new.Configurations)
+		response.Diagnostics.Append(diags...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &securitylake.UpdateDataLakeInput{
+			Configurations: configurations,
+		}
+
+		_, err := conn.UpdateDataLake(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Security Lake Data Lake (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitDataLakeUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Data Lake (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceDataLake) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceDataLakeData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	region, err := regionFromARN(data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Data Lake (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	tflog.Debug(ctx, "deleting Security Lake Data Lake", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err = conn.DeleteDataLake(ctx, &securitylake.DeleteDataLakeInput{
+		Regions: []string{region},
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Data Lake (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if err := waitDataLakeDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Data Lake (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceDataLake) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type resourceDataLakeData struct {
+	ARN                     types.String   `tfsdk:"arn"`
+	Configurations          types.List     `tfsdk:"configuration"`
+	ID                      types.String   `tfsdk:"id"`
+	MetaStoreManagerRoleARN fwtypes.ARN    `tfsdk:"meta_store_manager_role_arn"`
+	S3BucketARN             types.String   `tfsdk:"s3_bucket_arn"`
+	Tags                    types.Map      `tfsdk:"tags"`
+	TagsAll                 types.Map      `tfsdk:"tags_all"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+}
+
+type dataLakeConfigurationData struct {
+	EncryptionConfiguration  types.List   `tfsdk:"encryption_configuration"`
+	LifecycleConfiguration   types.List   `tfsdk:"lifecycle_configuration"`
+	Region                   types.String `tfsdk:"region"`
+	ReplicationConfiguration types.List   `tfsdk:"replication_configuration"`
+}
+
+type dataLakeEncryptionConfigurationData struct {
+	KMSKeyID types.String `tfsdk:"kms_key_id"`
+}
+
+type dataLakeLifecycleConfigurationData struct {
+	Expiration  types.List `tfsdk:"expiration"`
+	Transitions types.Set  `tfsdk:"transition"`
+}
+
+type dataLakeLifecycleExpirationData struct {
+	Days types.Int64 `tfsdk:"days"`
+}
+
+type dataLakeLifecycleTransitionData struct {
+	Days         types.Int64  `tfsdk:"days"`
+	StorageClass types.String `tfsdk:"storage_class"`
+}
+
+type dataLakeReplicationConfigurationData struct {
+	Regions types.Set   `tfsdk:"regions"`
+	RoleARN fwtypes.ARN `tfsdk:"role_arn"`
+}
+
+var (
+	dataLakeEncryptionConfigurationAttrTypes = map[string]attr.Type{
+		"kms_key_id": types.StringType,
+	}
+
+	dataLakeLifecycleExpirationAttrTypes = map[string]attr.Type{
+		"days": types.Int64Type,
+	}
+
+	dataLakeLifecycleTransitionAttrTypes = map[string]attr.Type{
+		"days":          types.Int64Type,
+		"storage_class": types.StringType,
+	}
+
+	dataLakeLifecycleConfigurationAttrTypes = map[string]attr.Type{
+		"expiration": types.ListType{ElemType: types.ObjectType{AttrTypes: dataLakeLifecycleExpirationAttrTypes}},
+		"transition": types.SetType{ElemType: types.ObjectType{AttrTypes: dataLakeLifecycleTransitionAttrTypes}},
+	}
+
+	dataLakeReplicationConfigurationAttrTypes = map[string]attr.Type{
+		"regions":  types.SetType{ElemType: types.StringType},
+		"role_arn": fwtypes.ARNType,
+	}
+
+	dataLakeConfigurationAttrTypes = map[string]attr.Type{
+		"encryption_configuration":  types.ListType{ElemType: types.ObjectType{AttrTypes: dataLakeEncryptionConfigurationAttrTypes}},
+		"lifecycle_configuration":   types.ListType{ElemType: types.ObjectType{AttrTypes: dataLakeLifecycleConfigurationAttrTypes}},
+		"region":                    types.StringType,
+		"replication_configuration": types.ListType{ElemType: types.ObjectType{AttrTypes: dataLakeReplicationConfigurationAttrTypes}},
+	}
+)
+
+func expandDataLakeConfigurations(ctx context.Context, tfList types.List) ([]awstypes.DataLakeConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var data []dataLakeConfigurationData
+
+	diags.Append(tfList.ElementsAs(ctx, &data, false)...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObjects := make([]awstypes.DataLakeConfiguration, 0, len(data))
+
+	for _, v := range data {
+		apiObject := awstypes.DataLakeConfiguration{
+			Region: flex.StringFromFramework(ctx, v.Region),
+		}
+
+		var encryptionConfigurations []dataLakeEncryptionConfigurationData
+		diags.Append(v.EncryptionConfiguration.ElementsAs(ctx, &encryptionConfigurations, false)...)
+
+		if len(encryptionConfigurations) > 0 {
+			apiObject.EncryptionConfiguration = &awstypes.DataLakeEncryptionConfiguration{
+				KmsKeyId: flex.StringFromFramework(ctx, encryptionConfigurations[0].KMSKeyID),
+			}
+		}
+
+		var lifecycleConfigurations []dataLakeLifecycleConfigurationData
+		diags.Append(v.LifecycleConfiguration.ElementsAs(ctx, &lifecycleConfigurations, false)...)
+
+		if len(lifecycleConfigurations) > 0 {
+			lifecycleConfiguration, d := expandDataLakeLifecycleConfiguration(ctx, lifecycleConfigurations[0])
+			diags.Append(d...)
+			apiObject.LifecycleConfiguration = lifecycleConfiguration
+		}
+
+		var replicationConfigurations []dataLakeReplicationConfigurationData
+		diags.Append(v.ReplicationConfiguration.ElementsAs(ctx, &replicationConfigurations, false)...)
+
+		if len(replicationConfigurations) > 0 {
+			apiObject.ReplicationConfiguration = &awstypes.DataLakeReplicationConfiguration{
+				Regions: flex.ExpandFrameworkStringValueSet(ctx, replicationConfigurations[0].Regions),
+				RoleArn: flex.ARNStringFromFramework(ctx, replicationConfigurations[0].RoleARN),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, diags
+}
+
+func expandDataLakeLifecycleConfiguration(ctx context.Context, data dataLakeLifecycleConfigurationData) (*awstypes.DataLakeLifecycleConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	apiObject := &awstypes.DataLakeLifecycleConfiguration{}
+
+	var expirations []dataLakeLifecycleExpirationData
+	diags.Append(data.Expiration.ElementsAs(ctx, &expirations, false)...)
+
+	if len(expirations) > 0 {
+		apiObject.Expiration = &awstypes.DataLakeLifecycleExpiration{
+			Days: aws.Int32(int32(expirations[0].Days.ValueInt64())),
+		}
+	}
+
+	var transitions []dataLakeLifecycleTransitionData
+	diags.Append(data.Transitions.ElementsAs(ctx, &transitions, false)...)
+
+	for _, v := range transitions {
+		apiObject.Transitions = append(apiObject.Transitions, awstypes.DataLakeLifecycleTransition{
+			Days:         aws.Int32(int32(v.Days.ValueInt64())),
+			StorageClass: flex.StringFromFramework(ctx, v.StorageClass),
+		})
+	}
+
+	return apiObject, diags
+}
+
+func flattenDataLakeConfigurations(ctx context.Context, apiObject *awstypes.DataLakeResource) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: dataLakeConfigurationAttrTypes}
+
+	if apiObject == nil {
+		return types.ListValueMust(elemType, []attr.Value{}), diags
+	}
+
+	encryptionConfiguration, d := flattenDataLakeEncryptionConfiguration(ctx, apiObject.EncryptionConfiguration)
+	diags.Append(d...)
+
+	lifecycleConfiguration, d := flattenDataLakeLifecycleConfiguration(ctx, apiObject.LifecycleConfiguration)
+	diags.Append(d...)
+
+	replicationConfiguration, d := flattenDataLakeReplicationConfiguration(ctx, apiObject.ReplicationConfiguration)
+	diags.Append(d...)
+
+	list, d := types.ListValueFrom(ctx, elemType, []dataLakeConfigurationData{{
+		EncryptionConfiguration:  encryptionConfiguration,
+		LifecycleConfiguration:   lifecycleConfiguration,
+		Region:                   flex.StringToFramework(ctx, apiObject.Region),
+		ReplicationConfiguration: replicationConfiguration,
+	}})
+	diags.Append(d...)
+
+	return list, diags
+}
+
+func flattenDataLakeEncryptionConfiguration(ctx context.Context, apiObject *awstypes.DataLakeEncryptionConfiguration) (types.List, diag.Diagnostics) {
+	elemType := types.ObjectType{AttrTypes: dataLakeEncryptionConfigurationAttrTypes}
+
+	// S3-managed encryption is the default and is represented by the absence of the block.
+	if apiObject == nil || aws.ToString(apiObject.KmsKeyId) == "" || aws.ToString(apiObject.KmsKeyId) == s3ManagedKMSKeyID {
+		return types.ListValueMust(elemType, []attr.Value{}), nil
+	}
+
+	return types.ListValueFrom(ctx, elemType, []dataLakeEncryptionConfigurationData{{
+		KMSKeyID: flex.StringToFramework(ctx, apiObject.KmsKeyId),
+	}})
+}
+
+func flattenDataLakeLifecycleConfiguration(ctx context.Context, apiObject *awstypes.DataLakeLifecycleConfiguration) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: dataLakeLifecycleConfigurationAttrTypes}
+
+	if apiObject == nil || (apiObject.Expiration == nil && len(apiObject.Transitions) == 0) {
+		return types.ListValueMust(elemType, []attr.Value{}), diags
+	}
+
+	expirationElemType := types.ObjectType{AttrTypes: dataLakeLifecycleExpirationAttrTypes}
+	expiration := types.ListValueMust(expirationElemType, []attr.Value{})
+
+	if v := apiObject.Expiration; v != nil {
+		list, d := types.ListValueFrom(ctx, expirationElemType, []dataLakeLifecycleExpirationData{{
+			Days: types.Int64Value(int64(aws.ToInt32(v.Days))),
+		}})
+		diags.Append(d...)
+		expiration = list
+	}
+
+	transitions := make([]dataLakeLifecycleTransitionData, 0, len(apiObject.Transitions))
+
+	for _, v := range apiObject.Transitions {
+		transitions = append(transitions, dataLakeLifecycleTransitionData{
+			Days:         types.Int64Value(int64(aws.ToInt32(v.Days))),
+			StorageClass: flex.StringToFramework(ctx, v.StorageClass),
+		})
+	}
+
+	transition, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: dataLakeLifecycleTransitionAttrTypes}, transitions)
+	diags.Append(d...)
+
+	list, d := types.ListValueFrom(ctx, elemType, []dataLakeLifecycleConfigurationData{{
+		Expiration:  expiration,
+		Transitions: transition,
+	}})
+	diags.Append(d...)
+
+	return list, diags
+}
+
+func flattenDataLakeReplicationConfiguration(ctx context.Context, apiObject *awstypes.DataLakeReplicationConfiguration) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: dataLakeReplicationConfigurationAttrTypes}
+
+	if apiObject == nil || (len(apiObject.Regions) == 0 && apiObject.RoleArn == nil) {
+		return types.ListValueMust(elemType, []attr.Value{}), diags
+	}
+
+	list, d := types.ListValueFrom(ctx, elemType, []dataLakeReplicationConfigurationData{{
+		Regions: flex.FlattenFrameworkStringValueSet(ctx, apiObject.Regions),
+		RoleARN: flex.StringToFrameworkARN(ctx, apiObject.RoleArn, &diags),
+	}})
+	diags.Append(d...)
+
+	return list, diags
+}
+
+func regionFromARN(s string) (string, error) {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return "", err
+	}
+
+	return v.Region, nil
+}
+
+func findDataLakeByARN(ctx context.Context, conn *securitylake.Client, arn string) (*awstypes.DataLakeResource, error) {
+	region, err := regionFromARN(arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &securitylake.ListDataLakesInput{
+		Regions: []string{region},
+	}
+
+	output, err := conn.ListDataLakes(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.DataLakes {
+		if aws.ToString(v.DataLakeArn) == arn {
+			v := v
+
+			return &v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func statusDataLakeCreate(ctx context.Context, conn *securitylake.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDataLakeByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.CreateStatus), nil
+	}
+}
+
+func statusDataLakeUpdate(ctx context.Context, conn *securitylake.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDataLakeByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.UpdateStatus == nil {
+			return nil, "", nil
+		}
+
+		return output, string(output.UpdateStatus.Status), nil
+	}
+}
+
+func waitDataLakeCreated(ctx context.Context, conn *securitylake.Client, arn string, timeout time.Duration) (*awstypes.DataLakeResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DataLakeStatusInitialized, awstypes.DataLakeStatusPending),
+		Target:  enum.Slice(awstypes.DataLakeStatusCompleted),
+		Refresh: statusDataLakeCreate(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DataLakeResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataLakeUpdated(ctx context.Context, conn *securitylake.Client, arn string, timeout time.Duration) (*awstypes.DataLakeResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DataLakeStatusInitialized, awstypes.DataLakeStatusPending),
+		Target:  enum.Slice(awstypes.DataLakeStatusCompleted),
+		Refresh: statusDataLakeUpdate(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DataLakeResource); ok {
+		if v := output.UpdateStatus; v != nil && v.Exception != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(v.Exception.Code), aws.ToString(v.Exception.Reason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataLakeDeleted(ctx context.Context, conn *securitylake.Client, arn string, timeout time.Duration) error {
+	return tfresource.WaitUntil(ctx, timeout, func() (bool, error) {
+		_, err := findDataLakeByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return true, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return false, nil
+	}, tfresource.WaitOpts{
+		Delay:        30 * time.Second,
+		PollInterval: 10 * time.Second,
+	})
+}
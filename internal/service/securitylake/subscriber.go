@@ -0,0 +1,665 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Subscriber")
+// @Tags(identifierAttribute="arn")
+func newResourceSubscriber(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceSubscriber{}
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type resourceSubscriber struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceSubscriber) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_subscriber"
+}
+
+func (r *resourceSubscriber) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	logSourceResourceBlock := func(validators ...validator.String) schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"source_name": schema.StringAttribute{
+						Required:   true,
+						Validators: validators,
+					},
+					"source_version": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.AccessType](),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			"resource_share_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_share_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"s3_bucket_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscriber_description": schema.StringAttribute{
+				Optional: true,
+			},
+			"subscriber_endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscriber_name": schema.StringAttribute{
+				Required: true,
+			},
+			"subscriber_status": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"source": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"aws_log_source_resource": logSourceResourceBlock(
+							enum.FrameworkValidate[awstypes.AwsLogSourceName](),
+						),
+						"custom_log_source_resource": logSourceResourceBlock(),
+					},
+					Validators: []validator.Object{
+						objectvalidator.ExactlyOneOf(
+							path.MatchRelative().AtName("aws_log_source_resource"),
+							path.MatchRelative().AtName("custom_log_source_resource"),
+						),
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"subscriber_identity": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"external_id": schema.StringAttribute{
+							Required: true,
+						},
+						"principal": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceSubscriber) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceSubscriberData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	sources, diags := expandLogSourceResources(ctx, data.Sources)
+	response.Diagnostics.Append(diags...)
+
+	subscriberIdentity, diags := expandSubscriberIdentity(ctx, data.SubscriberIdentity)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &securitylake.CreateSubscriberInput{
+		Sources:               sources,
+		SubscriberDescription: flex.StringFromFramework(ctx, data.SubscriberDescription),
+		SubscriberIdentity:    subscriberIdentity,
+		SubscriberName:        flex.StringFromFramework(ctx, data.SubscriberName),
+		Tags:                  getTagsIn(ctx),
+	}
+
+	if !data.AccessType.IsUnknown() && !data.AccessType.IsNull() {
+		input.AccessTypes = []awstypes.AccessType{awstypes.AccessType(data.AccessType.ValueString())}
+	}
+
+	output, err := conn.CreateSubscriber(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Lake Subscriber (%s)", data.SubscriberName.ValueString()), err.Error())
+
+		return
+	}
+
+	if output.Subscriber == nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Lake Subscriber (%s)", data.SubscriberName.ValueString()), tfresource.NewEmptyResultError(input).Error())
+
+		return
+	}
+
+	data.ID = flex.StringToFramework(ctx, output.Subscriber.SubscriberId)
+
+	subscriber, err := waitSubscriberCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Subscriber (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, subscriber)...)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSubscriber) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceSubscriberData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	subscriber, err := findSubscriberByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Subscriber (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, subscriber)...)
+
+	sources, diags := flattenLogSourceResources(ctx, subscriber.Sources)
+	response.Diagnostics.Append(diags...)
+	data.Sources = sources
+
+	subscriberIdentity, diags := flattenAWSIdentity(ctx, subscriber.SubscriberIdentity)
+	response.Diagnostics.Append(diags...)
+	data.SubscriberIdentity = subscriberIdentity
+
+	data.SubscriberDescription = flex.StringToFramework(ctx, subscriber.SubscriberDescription)
+	data.SubscriberName = flex.StringToFramework(ctx, subscriber.SubscriberName)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSubscriber) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceSubscriberData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	if !new.Sources.Equal(old.Sources) ||
+		!new.SubscriberDescription.Equal(old.SubscriberDescription) ||
+		!new.SubscriberIdentity.Equal(old.SubscriberIdentity) ||
+		!new.SubscriberName.Equal(old.SubscriberName) {
+		sources, diags := expandLogSourceResources(ctx, new.Sources)
+		response.Diagnostics.Append(diags...)
+
+		subscriberIdentity, diags := expandSubscriberIdentity(ctx, new.SubscriberIdentity)
+		response.Diagnostics.Append(diags...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &securitylake.UpdateSubscriberInput{
+			Sources:               sources,
+			SubscriberDescription: flex.StringFromFramework(ctx, new.SubscriberDescription),
+			SubscriberId:          flex.StringFromFramework(ctx, new.ID),
+			SubscriberIdentity:    subscriberIdentity,
+			SubscriberName:        flex.StringFromFramework(ctx, new.SubscriberName),
+		}
+
+		_, err := conn.UpdateSubscriber(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Security Lake Subscriber (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		subscriber, err := waitSubscriberUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Subscriber (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.refreshFromOutput(ctx, subscriber)...)
+	} else {
+		new.SubscriberStatus = old.SubscriberStatus
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceSubscriber) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceSubscriberData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	tflog.Debug(ctx, "deleting Security Lake Subscriber", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeleteSubscriber(ctx, &securitylake.DeleteSubscriberInput{
+		SubscriberId: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Subscriber (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitSubscriberDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Subscriber (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceSubscriber) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type resourceSubscriberData struct {
+	AccessType            types.String   `tfsdk:"access_type"`
+	ARN                   types.String   `tfsdk:"arn"`
+	ID                    types.String   `tfsdk:"id"`
+	ResourceShareARN      types.String   `tfsdk:"resource_share_arn"`
+	ResourceShareName     types.String   `tfsdk:"resource_share_name"`
+	RoleARN               types.String   `tfsdk:"role_arn"`
+	S3BucketARN           types.String   `tfsdk:"s3_bucket_arn"`
+	Sources               types.List     `tfsdk:"source"`
+	SubscriberDescription types.String   `tfsdk:"subscriber_description"`
+	SubscriberEndpoint    types.String   `tfsdk:"subscriber_endpoint"`
+	SubscriberIdentity    types.List     `tfsdk:"subscriber_identity"`
+	SubscriberName        types.String   `tfsdk:"subscriber_name"`
+	SubscriberStatus      types.String   `tfsdk:"subscriber_status"`
+	Tags                  types.Map      `tfsdk:"tags"`
+	TagsAll               types.Map      `tfsdk:"tags_all"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+type logSourceResourceData struct {
+	AWSLogSourceResource    types.List `tfsdk:"aws_log_source_resource"`
+	CustomLogSourceResource types.List `tfsdk:"custom_log_source_resource"`
+}
+
+type logSourceResourceSourceData struct {
+	SourceName    types.String `tfsdk:"source_name"`
+	SourceVersion types.String `tfsdk:"source_version"`
+}
+
+var (
+	logSourceResourceSourceAttrTypes = map[string]attr.Type{
+		"source_name":    types.StringType,
+		"source_version": types.StringType,
+	}
+
+	logSourceResourceAttrTypes = map[string]attr.Type{
+		"aws_log_source_resource":    types.ListType{ElemType: types.ObjectType{AttrTypes: logSourceResourceSourceAttrTypes}},
+		"custom_log_source_resource": types.ListType{ElemType: types.ObjectType{AttrTypes: logSourceResourceSourceAttrTypes}},
+	}
+)
+
+// refreshFromOutput sets the computed attributes from the API response.
+func (data *resourceSubscriberData) refreshFromOutput(ctx context.Context, apiObject *awstypes.SubscriberResource) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(apiObject.AccessTypes) > 0 {
+		data.AccessType = flex.StringValueToFramework(ctx, apiObject.AccessTypes[0])
+	} else {
+		data.AccessType = types.StringNull()
+	}
+	data.ARN = flex.StringToFramework(ctx, apiObject.SubscriberArn)
+	data.ResourceShareARN = flex.StringToFramework(ctx, apiObject.ResourceShareArn)
+	data.ResourceShareName = flex.StringToFramework(ctx, apiObject.ResourceShareName)
+	data.RoleARN = flex.StringToFramework(ctx, apiObject.RoleArn)
+	data.S3BucketARN = flex.StringToFramework(ctx, apiObject.S3BucketArn)
+	data.SubscriberEndpoint = flex.StringToFramework(ctx, apiObject.SubscriberEndpoint)
+	data.SubscriberStatus = flex.StringValueToFramework(ctx, apiObject.SubscriberStatus)
+
+	// Fill in any source versions chosen by the service.
+	if !data.Sources.IsNull() && !data.Sources.IsUnknown() {
+		sources, d := flattenLogSourceResources(ctx, apiObject.Sources)
+		diags.Append(d...)
+
+		if len(sources.Elements()) == len(data.Sources.Elements()) {
+			data.Sources = sources
+		}
+	}
+
+	return diags
+}
+
+func expandSubscriberIdentity(ctx context.Context, tfList types.List) (*awstypes.AwsIdentity, diag.Diagnostics) {
+	var data []awsIdentityData
+	diags := tfList.ElementsAs(ctx, &data, false)
+
+	if diags.HasError() || len(data) == 0 {
+		return nil, diags
+	}
+
+	return expandAWSIdentity(ctx, data[0]), diags
+}
+
+func expandLogSourceResources(ctx context.Context, tfList types.List) ([]awstypes.LogSourceResource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var data []logSourceResourceData
+
+	diags.Append(tfList.ElementsAs(ctx, &data, false)...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObjects := make([]awstypes.LogSourceResource, 0, len(data))
+
+	for _, v := range data {
+		var sources []logSourceResourceSourceData
+
+		diags.Append(v.AWSLogSourceResource.ElementsAs(ctx, &sources, false)...)
+
+		if len(sources) > 0 {
+			apiObject := &awstypes.LogSourceResourceMemberAwsLogSource{
+				Value: awstypes.AwsLogSourceResource{
+					SourceName: awstypes.AwsLogSourceName(sources[0].SourceName.ValueString()),
+				},
+			}
+
+			if !sources[0].SourceVersion.IsUnknown() {
+				apiObject.Value.SourceVersion = flex.StringFromFramework(ctx, sources[0].SourceVersion)
+			}
+
+			apiObjects = append(apiObjects, apiObject)
+
+			continue
+		}
+
+		diags.Append(v.CustomLogSourceResource.ElementsAs(ctx, &sources, false)...)
+
+		if len(sources) > 0 {
+			apiObject := &awstypes.LogSourceResourceMemberCustomLogSource{
+				Value: awstypes.CustomLogSourceResource{
+					SourceName: flex.StringFromFramework(ctx, sources[0].SourceName),
+				},
+			}
+
+			if !sources[0].SourceVersion.IsUnknown() {
+				apiObject.Value.SourceVersion = flex.StringFromFramework(ctx, sources[0].SourceVersion)
+			}
+
+			apiObjects = append(apiObjects, apiObject)
+		}
+	}
+
+	return apiObjects, diags
+}
+
+func flattenLogSourceResources(ctx context.Context, apiObjects []awstypes.LogSourceResource) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: logSourceResourceAttrTypes}
+	sourceElemType := types.ObjectType{AttrTypes: logSourceResourceSourceAttrTypes}
+	data := make([]logSourceResourceData, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		var sourceName, sourceVersion types.String
+		v := logSourceResourceData{
+			AWSLogSourceResource:    types.ListValueMust(sourceElemType, []attr.Value{}),
+			CustomLogSourceResource: types.ListValueMust(sourceElemType, []attr.Value{}),
+		}
+
+		switch apiObject := apiObject.(type) {
+		case *awstypes.LogSourceResourceMemberAwsLogSource:
+			sourceName = flex.StringValueToFramework(ctx, apiObject.Value.SourceName)
+			sourceVersion = flex.StringToFramework(ctx, apiObject.Value.SourceVersion)
+		case *awstypes.LogSourceResourceMemberCustomLogSource:
+			sourceName = flex.StringToFramework(ctx, apiObject.Value.SourceName)
+			sourceVersion = flex.StringToFramework(ctx, apiObject.Value.SourceVersion)
+		default:
+			continue
+		}
+
+		list, d := types.ListValueFrom(ctx, sourceElemType, []logSourceResourceSourceData{{
+			SourceName:    sourceName,
+			SourceVersion: sourceVersion,
+		}})
+		diags.Append(d...)
+
+		if _, ok := apiObject.(*awstypes.LogSourceResourceMemberAwsLogSource); ok {
+			v.AWSLogSourceResource = list
+		} else {
+			v.CustomLogSourceResource = list
+		}
+
+		data = append(data, v)
+	}
+
+	list, d := types.ListValueFrom(ctx, elemType, data)
+	diags.Append(d...)
+
+	return list, diags
+}
+
+func findSubscriberByID(ctx context.Context, conn *securitylake.Client, id string) (*awstypes.SubscriberResource, error) {
+	input := &securitylake.GetSubscriberInput{
+		SubscriberId: aws.String(id),
+	}
+
+	output, err := conn.GetSubscriber(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Subscriber == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Subscriber, nil
+}
+
+func statusSubscriber(ctx context.Context, conn *securitylake.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findSubscriberByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.SubscriberStatus), nil
+	}
+}
+
+func waitSubscriberCreated(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*awstypes.SubscriberResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SubscriberStatusPending),
+		Target:  enum.Slice(awstypes.SubscriberStatusActive, awstypes.SubscriberStatusReady),
+		Refresh: statusSubscriber(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSubscriberUpdated(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*awstypes.SubscriberResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.SubscriberStatusPending),
+		Target:                    enum.Slice(awstypes.SubscriberStatusActive, awstypes.SubscriberStatusReady),
+		Refresh:                   statusSubscriber(ctx, conn, id),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSubscriberDeleted(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*awstypes.SubscriberResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SubscriberStatusActive, awstypes.SubscriberStatusDeactivated, awstypes.SubscriberStatusPending, awstypes.SubscriberStatusReady),
+		Target:  []string{},
+		Refresh: statusSubscriber(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build sweep
+// +build sweep
+
+package securitylake
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_securitylake_data_lake", &resource.Sweeper{
+		Name: "aws_securitylake_data_lake",
+		F:    sweepDataLakes,
+		Dependencies: []string{
+			"aws_securitylake_aws_log_source",
+			"aws_securitylake_custom_log_source",
+			"aws_securitylake_subscriber",
+		},
+	})
+	sweep.AddTestSweepers("aws_securitylake_aws_log_source", &resource.Sweeper{
+		Name: "aws_securitylake_aws_log_source",
+		F:    sweepAWSLogSources,
+		Dependencies: []string{
+			"aws_securitylake_subscriber",
+		},
+	})
+	sweep.AddTestSweepers("aws_securitylake_custom_log_source", &resource.Sweeper{
+		Name: "aws_securitylake_custom_log_source",
+		F:    sweepCustomLogSources,
+		Dependencies: []string{
+			"aws_securitylake_subscriber",
+		},
+	})
+	sweep.AddTestSweepers("aws_securitylake_subscriber", &resource.Sweeper{
+		Name: "aws_securitylake_subscriber",
+		F:    sweepSubscribers,
+	})
+}
+
+func sweepDataLakes(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.SecurityLakeClient(ctx)
+	input := &securitylake.ListDataLakesInput{
+		Regions: []string{region},
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	output, err := conn.ListDataLakes(ctx, input)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Security Lake Data Lake sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Security Lake Data Lakes (%s): %w", region, err)
+	}
+
+	for _, v := range output.DataLakes {
+		sweepResources = append(sweepResources, framework.NewSweepResource(newResourceDataLake, client,
+			framework.NewAttribute("id", aws.ToString(v.DataLakeArn)),
+		))
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Security Lake Data Lakes (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepAWSLogSources(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.SecurityLakeClient(ctx)
+	input := &securitylake.ListLogSourcesInput{
+		Regions: []string{region},
+	}
+	sourceNames := make(map[awstypes.AwsLogSourceName]struct{})
+
+	pages := securitylake.NewListLogSourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Security Lake AWS Log Source sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Security Lake Log Sources (%s): %w", region, err)
+		}
+
+		for _, logSource := range page.Sources {
+			for _, v := range logSource.Sources {
+				if v, ok := v.(*awstypes.LogSourceResourceMemberAwsLogSource); ok {
+					sourceNames[v.Value.SourceName] = struct{}{}
+				}
+			}
+		}
+	}
+
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for sourceName := range sourceNames {
+		sweepResources = append(sweepResources, framework.NewSweepResource(newResourceAWSLogSource, client,
+			framework.NewAttribute("id", string(sourceName)),
+		))
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Security Lake AWS Log Sources (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepCustomLogSources(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.SecurityLakeClient(ctx)
+	input := &securitylake.ListLogSourcesInput{
+		Regions: []string{region},
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := securitylake.NewListLogSourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Security Lake Custom Log Source sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Security Lake Log Sources (%s): %w", region, err)
+		}
+
+		for _, logSource := range page.Sources {
+			for _, v := range logSource.Sources {
+				if v, ok := v.(*awstypes.LogSourceResourceMemberCustomLogSource); ok {
+					sweepResources = append(sweepResources, framework.NewSweepResource(newResourceCustomLogSource, client,
+						framework.NewAttribute("id", aws.ToString(v.Value.SourceName)),
+						framework.NewAttribute("source_version", aws.ToString(v.Value.SourceVersion)),
+					))
+				}
+			}
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Security Lake Custom Log Sources (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepSubscribers(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.SecurityLakeClient(ctx)
+	input := &securitylake.ListSubscribersInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := securitylake.NewListSubscribersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Security Lake Subscriber sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Security Lake Subscribers (%s): %w", region, err)
+		}
+
+		for _, v := range page.Subscribers {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newResourceSubscriber, client,
+				framework.NewAttribute("id", aws.ToString(v.SubscriberId)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Security Lake Subscribers (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -24,3 +24,8 @@ func (p *servicePackage) NewConn(ctx context.Context, m map[string]any) (*shield
 
 	return shield_sdkv1.New(sess.Copy(config)), nil
 }
+
+// IsGlobal returns true as Shield resources are not Regional.
+func (p *servicePackage) IsGlobal() bool {
+	return true
+}
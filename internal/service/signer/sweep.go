@@ -18,7 +18,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_signer_signing_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_signer_signing_profile", &resource.Sweeper{
 		Name: "aws_signer_signing_profile",
 		F:    sweepSigningProfiles,
 	})
@@ -0,0 +1,370 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ssoadmin_application", name="Application")
+// @Tags
+func ResourceApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationCreate,
+		ReadWithoutTimeout:   resourceApplicationRead,
+		UpdateWithoutTimeout: resourceApplicationUpdate,
+		DeleteWithoutTimeout: resourceApplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_account": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"application_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"application_provider_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"portal_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sign_in_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"application_url": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+									"origin": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ssoadmin.SignInOrigin_Values(), false),
+									},
+								},
+							},
+						},
+						"visibility": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(ssoadmin.ApplicationVisibility_Values(), false),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ssoadmin.ApplicationStatus_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &ssoadmin.CreateApplicationInput{
+		ApplicationProviderArn: aws.String(d.Get("application_provider_arn").(string)),
+		ClientToken:            aws.String(id.UniqueId()),
+		InstanceArn:            aws.String(d.Get("instance_arn").(string)),
+		Name:                   aws.String(name),
+		Tags:                   getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("portal_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.PortalOptions = expandPortalOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("status"); ok {
+		input.Status = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateApplicationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSO Application (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ApplicationArn))
+
+	return append(diags, resourceApplicationRead(ctx, d, meta)...)
+}
+
+func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn(ctx)
+
+	output, err := FindApplicationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSO Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSO Application (%s): %s", d.Id(), err)
+	}
+
+	instanceARN := aws.StringValue(output.InstanceArn)
+	d.Set("application_account", output.ApplicationAccount)
+	d.Set("application_arn", output.ApplicationArn)
+	d.Set("application_provider_arn", output.ApplicationProviderArn)
+	d.Set("created_date", aws.TimeValue(output.CreatedDate).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("instance_arn", instanceARN)
+	d.Set("name", output.Name)
+	if output.PortalOptions != nil {
+		if err := d.Set("portal_options", []interface{}{flattenPortalOptions(output.PortalOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting portal_options: %s", err)
+		}
+	} else {
+		d.Set("portal_options", nil)
+	}
+	d.Set("status", output.Status)
+
+	tags, err := listTags(ctx, conn, d.Id(), instanceARN)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for SSO Application (%s): %s", d.Id(), err)
+	}
+
+	setTagsOut(ctx, Tags(tags))
+
+	return diags
+}
+
+func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn(ctx)
+
+	if d.HasChanges("description", "name", "portal_options", "status") {
+		input := &ssoadmin.UpdateApplicationInput{
+			ApplicationArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("portal_options") {
+			if v, ok := d.GetOk("portal_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.PortalOptions = expandUpdateApplicationPortalOptions(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("status") {
+			input.Status = aws.String(d.Get("status").(string))
+		}
+
+		_, err := conn.UpdateApplicationWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSO Application (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := updateTags(ctx, conn, d.Id(), d.Get("instance_arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSO Application (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceApplicationRead(ctx, d, meta)...)
+}
+
+func resourceApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn(ctx)
+
+	log.Printf("[INFO] Deleting SSO Application: %s", d.Id())
+	_, err := conn.DeleteApplicationWithContext(ctx, &ssoadmin.DeleteApplicationInput{
+		ApplicationArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSO Application (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindApplicationByARN(ctx context.Context, conn *ssoadmin.SSOAdmin, arn string) (*ssoadmin.DescribeApplicationOutput, error) {
+	input := &ssoadmin.DescribeApplicationInput{
+		ApplicationArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeApplicationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandPortalOptions(tfMap map[string]interface{}) *ssoadmin.PortalOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ssoadmin.PortalOptions{}
+
+	if v, ok := tfMap["sign_in_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SignInOptions = expandSignInOptions(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["visibility"].(string); ok && v != "" {
+		apiObject.Visibility = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandUpdateApplicationPortalOptions(tfMap map[string]interface{}) *ssoadmin.UpdateApplicationPortalOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ssoadmin.UpdateApplicationPortalOptions{}
+
+	if v, ok := tfMap["sign_in_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SignInOptions = expandSignInOptions(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandSignInOptions(tfMap map[string]interface{}) *ssoadmin.SignInOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ssoadmin.SignInOptions{}
+
+	if v, ok := tfMap["application_url"].(string); ok && v != "" {
+		apiObject.ApplicationUrl = aws.String(v)
+	}
+
+	if v, ok := tfMap["origin"].(string); ok && v != "" {
+		apiObject.Origin = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenPortalOptions(apiObject *ssoadmin.PortalOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"visibility": aws.StringValue(apiObject.Visibility),
+	}
+
+	if v := apiObject.SignInOptions; v != nil {
+		tfMap["sign_in_options"] = []interface{}{flattenSignInOptions(v)}
+	}
+
+	return tfMap
+}
+
+func flattenSignInOptions(apiObject *ssoadmin.SignInOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"application_url": aws.StringValue(apiObject.ApplicationUrl),
+		"origin":          aws.StringValue(apiObject.Origin),
+	}
+
+	return tfMap
+}
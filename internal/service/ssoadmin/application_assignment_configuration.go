@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_ssoadmin_application_assignment_configuration", name="Application Assignment Configuration")
+func ResourceApplicationAssignmentConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationAssignmentConfigurationPut,
+		ReadWithoutTimeout:   resourceApplicationAssignmentConfigurationRead,
+		UpdateWithoutTimeout: resourceApplicationAssignmentConfigurationPut,
+		DeleteWithoutTimeout: resourceApplicationAssignmentConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"assignment_required": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceApplicationAssignmentConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn(ctx)
+
+	applicationARN := d.Get("application_arn").(string)
+	input := &ssoadmin.PutApplicationAssignmentConfigurationInput{
+		ApplicationArn:     aws.String(applicationARN),
+		AssignmentRequired: aws.Bool(d.Get("assignment_required").(bool)),
+	}
+
+	_, err := conn.PutApplicationAssignmentConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting SSO Application Assignment Configuration (%s): %s", applicationARN, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(applicationARN)
+	}
+
+	return append(diags, resourceApplicationAssignmentConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceApplicationAssignmentConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn(ctx)
+
+	output, err := FindApplicationAssignmentConfigurationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSO Application Assignment Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSO Application Assignment Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_arn", d.Id())
+	d.Set("assignment_required", output.AssignmentRequired)
+
+	return diags
+}
+
+func resourceApplicationAssignmentConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn(ctx)
+
+	// Assignments are required by default, so restore that on delete.
+	log.Printf("[INFO] Deleting SSO Application Assignment Configuration: %s", d.Id())
+	_, err := conn.PutApplicationAssignmentConfigurationWithContext(ctx, &ssoadmin.PutApplicationAssignmentConfigurationInput{
+		ApplicationArn:     aws.String(d.Id()),
+		AssignmentRequired: aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSO Application Assignment Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindApplicationAssignmentConfigurationByARN(ctx context.Context, conn *ssoadmin.SSOAdmin, arn string) (*ssoadmin.GetApplicationAssignmentConfigurationOutput, error) {
+	input := &ssoadmin.GetApplicationAssignmentConfigurationInput{
+		ApplicationArn: aws.String(arn),
+	}
+
+	output, err := conn.GetApplicationAssignmentConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
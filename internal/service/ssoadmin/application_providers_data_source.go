@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_ssoadmin_application_providers", name="Application Providers")
+func DataSourceApplicationProviders() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceApplicationProvidersRead,
+
+		Schema: map[string]*schema.Schema{
+			"application_providers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_provider_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_data": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"display_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"icon_url": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"federation_protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceApplicationProvidersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn(ctx)
+
+	output, err := findApplicationProviders(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSO Application Providers: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("application_providers", flattenApplicationProviders(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting application_providers: %s", err)
+	}
+
+	return diags
+}
+
+func findApplicationProviders(ctx context.Context, conn *ssoadmin.SSOAdmin) ([]*ssoadmin.ApplicationProvider, error) {
+	input := &ssoadmin.ListApplicationProvidersInput{}
+	var output []*ssoadmin.ApplicationProvider
+
+	err := conn.ListApplicationProvidersPagesWithContext(ctx, input, func(page *ssoadmin.ListApplicationProvidersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ApplicationProviders {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func flattenApplicationProviders(apiObjects []*ssoadmin.ApplicationProvider) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"application_provider_arn": aws.StringValue(apiObject.ApplicationProviderArn),
+			"federation_protocol":      aws.StringValue(apiObject.FederationProtocol),
+		}
+
+		if v := apiObject.DisplayData; v != nil {
+			tfMap["display_data"] = []interface{}{map[string]interface{}{
+				"description":  aws.StringValue(v.Description),
+				"display_name": aws.StringValue(v.DisplayName),
+				"icon_url":     aws.StringValue(v.IconUrl),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
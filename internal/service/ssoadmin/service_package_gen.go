@@ -25,6 +25,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceApplicationProviders,
+			TypeName: "aws_ssoadmin_application_providers",
+			Name:     "Application Providers",
+		},
 		{
 			Factory:  DataSourceInstances,
 			TypeName: "aws_ssoadmin_instances",
@@ -42,6 +47,27 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceAccountAssignment,
 			TypeName: "aws_ssoadmin_account_assignment",
 		},
+		{
+			Factory:  ResourceApplication,
+			TypeName: "aws_ssoadmin_application",
+			Name:     "Application",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
+		{
+			Factory:  ResourceApplicationAccessScope,
+			TypeName: "aws_ssoadmin_application_access_scope",
+			Name:     "Application Access Scope",
+		},
+		{
+			Factory:  ResourceApplicationAssignment,
+			TypeName: "aws_ssoadmin_application_assignment",
+			Name:     "Application Assignment",
+		},
+		{
+			Factory:  ResourceApplicationAssignmentConfiguration,
+			TypeName: "aws_ssoadmin_application_assignment_configuration",
+			Name:     "Application Assignment Configuration",
+		},
 		{
 			Factory:  ResourceCustomerManagedPolicyAttachment,
 			TypeName: "aws_ssoadmin_customer_managed_policy_attachment",
@@ -68,6 +94,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourcePermissionsBoundaryAttachment,
 			TypeName: "aws_ssoadmin_permissions_boundary_attachment",
 		},
+		{
+			Factory:  ResourceTrustedTokenIssuer,
+			TypeName: "aws_ssoadmin_trusted_token_issuer",
+			Name:     "Trusted Token Issuer",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 	}
 }
 
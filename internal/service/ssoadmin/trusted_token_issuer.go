@@ -0,0 +1,400 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ssoadmin_trusted_token_issuer", name="Trusted Token Issuer")
+// @Tags
+func ResourceTrustedTokenIssuer() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTrustedTokenIssuerCreate,
+		ReadWithoutTimeout:   resourceTrustedTokenIssuerRead,
+		UpdateWithoutTimeout: resourceTrustedTokenIssuerUpdate,
+		DeleteWithoutTimeout: resourceTrustedTokenIssuerDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"trusted_token_issuer_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"oidc_jwt_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"claim_attribute_path": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"identity_store_attribute_path": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"issuer_url": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"jwks_retrieval_option": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ssoadmin.JwksRetrievalOption_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"trusted_token_issuer_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ssoadmin.TrustedTokenIssuerType_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceTrustedTokenIssuerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn(ctx)
+
+	instanceARN := d.Get("instance_arn").(string)
+	name := d.Get("name").(string)
+	input := &ssoadmin.CreateTrustedTokenIssuerInput{
+		ClientToken:            aws.String(id.UniqueId()),
+		InstanceArn:            aws.String(instanceARN),
+		Name:                   aws.String(name),
+		Tags:                   getTagsIn(ctx),
+		TrustedTokenIssuerType: aws.String(d.Get("trusted_token_issuer_type").(string)),
+	}
+
+	if v, ok := d.GetOk("trusted_token_issuer_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TrustedTokenIssuerConfiguration = expandTrustedTokenIssuerConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateTrustedTokenIssuerWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSO Trusted Token Issuer (%s): %s", name, err)
+	}
+
+	d.SetId(TrustedTokenIssuerCreateResourceID(aws.StringValue(output.TrustedTokenIssuerArn), instanceARN))
+
+	return append(diags, resourceTrustedTokenIssuerRead(ctx, d, meta)...)
+}
+
+func resourceTrustedTokenIssuerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn(ctx)
+
+	arn, instanceARN, err := TrustedTokenIssuerParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindTrustedTokenIssuerByARN(ctx, conn, arn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSO Trusted Token Issuer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSO Trusted Token Issuer (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.TrustedTokenIssuerArn)
+	d.Set("instance_arn", instanceARN)
+	d.Set("name", output.Name)
+	if output.TrustedTokenIssuerConfiguration != nil {
+		if err := d.Set("trusted_token_issuer_configuration", []interface{}{flattenTrustedTokenIssuerConfiguration(output.TrustedTokenIssuerConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting trusted_token_issuer_configuration: %s", err)
+		}
+	} else {
+		d.Set("trusted_token_issuer_configuration", nil)
+	}
+	d.Set("trusted_token_issuer_type", output.TrustedTokenIssuerType)
+
+	tags, err := listTags(ctx, conn, arn, instanceARN)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for SSO Trusted Token Issuer (%s): %s", d.Id(), err)
+	}
+
+	setTagsOut(ctx, Tags(tags))
+
+	return diags
+}
+
+func resourceTrustedTokenIssuerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn(ctx)
+
+	arn, instanceARN, err := TrustedTokenIssuerParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChanges("name", "trusted_token_issuer_configuration") {
+		input := &ssoadmin.UpdateTrustedTokenIssuerInput{
+			TrustedTokenIssuerArn: aws.String(arn),
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("trusted_token_issuer_configuration") {
+			if v, ok := d.GetOk("trusted_token_issuer_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.TrustedTokenIssuerConfiguration = expandTrustedTokenIssuerUpdateConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		_, err := conn.UpdateTrustedTokenIssuerWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSO Trusted Token Issuer (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := updateTags(ctx, conn, arn, instanceARN, o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSO Trusted Token Issuer (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTrustedTokenIssuerRead(ctx, d, meta)...)
+}
+
+func resourceTrustedTokenIssuerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn(ctx)
+
+	arn, _, err := TrustedTokenIssuerParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[INFO] Deleting SSO Trusted Token Issuer: %s", d.Id())
+	_, err = conn.DeleteTrustedTokenIssuerWithContext(ctx, &ssoadmin.DeleteTrustedTokenIssuerInput{
+		TrustedTokenIssuerArn: aws.String(arn),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSO Trusted Token Issuer (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// Trusted token issuer IDs include the instance ARN, which DescribeTrustedTokenIssuer doesn't return but tagging requires.
+const trustedTokenIssuerIDSeparator = ","
+
+func TrustedTokenIssuerCreateResourceID(trustedTokenIssuerARN, instanceARN string) string {
+	parts := []string{trustedTokenIssuerARN, instanceARN}
+	id := strings.Join(parts, trustedTokenIssuerIDSeparator)
+
+	return id
+}
+
+func TrustedTokenIssuerParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, trustedTokenIssuerIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected TRUSTED_TOKEN_ISSUER_ARN%[2]sINSTANCE_ARN", id, trustedTokenIssuerIDSeparator)
+}
+
+func FindTrustedTokenIssuerByARN(ctx context.Context, conn *ssoadmin.SSOAdmin, arn string) (*ssoadmin.DescribeTrustedTokenIssuerOutput, error) {
+	input := &ssoadmin.DescribeTrustedTokenIssuerInput{
+		TrustedTokenIssuerArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeTrustedTokenIssuerWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandTrustedTokenIssuerConfiguration(tfMap map[string]interface{}) *ssoadmin.TrustedTokenIssuerConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ssoadmin.TrustedTokenIssuerConfiguration{}
+
+	if v, ok := tfMap["oidc_jwt_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OidcJwtConfiguration = expandOIDCJWTConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandOIDCJWTConfiguration(tfMap map[string]interface{}) *ssoadmin.OidcJwtConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ssoadmin.OidcJwtConfiguration{}
+
+	if v, ok := tfMap["claim_attribute_path"].(string); ok && v != "" {
+		apiObject.ClaimAttributePath = aws.String(v)
+	}
+
+	if v, ok := tfMap["identity_store_attribute_path"].(string); ok && v != "" {
+		apiObject.IdentityStoreAttributePath = aws.String(v)
+	}
+
+	if v, ok := tfMap["issuer_url"].(string); ok && v != "" {
+		apiObject.IssuerUrl = aws.String(v)
+	}
+
+	if v, ok := tfMap["jwks_retrieval_option"].(string); ok && v != "" {
+		apiObject.JwksRetrievalOption = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTrustedTokenIssuerUpdateConfiguration(tfMap map[string]interface{}) *ssoadmin.TrustedTokenIssuerUpdateConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ssoadmin.TrustedTokenIssuerUpdateConfiguration{}
+
+	if v, ok := tfMap["oidc_jwt_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OidcJwtConfiguration = expandOIDCJWTUpdateConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandOIDCJWTUpdateConfiguration(tfMap map[string]interface{}) *ssoadmin.OidcJwtUpdateConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ssoadmin.OidcJwtUpdateConfiguration{}
+
+	if v, ok := tfMap["claim_attribute_path"].(string); ok && v != "" {
+		apiObject.ClaimAttributePath = aws.String(v)
+	}
+
+	if v, ok := tfMap["identity_store_attribute_path"].(string); ok && v != "" {
+		apiObject.IdentityStoreAttributePath = aws.String(v)
+	}
+
+	if v, ok := tfMap["jwks_retrieval_option"].(string); ok && v != "" {
+		apiObject.JwksRetrievalOption = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenTrustedTokenIssuerConfiguration(apiObject *ssoadmin.TrustedTokenIssuerConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.OidcJwtConfiguration; v != nil {
+		tfMap["oidc_jwt_configuration"] = []interface{}{flattenOIDCJWTConfiguration(v)}
+	}
+
+	return tfMap
+}
+
+func flattenOIDCJWTConfiguration(apiObject *ssoadmin.OidcJwtConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"claim_attribute_path":          aws.StringValue(apiObject.ClaimAttributePath),
+		"identity_store_attribute_path": aws.StringValue(apiObject.IdentityStoreAttributePath),
+		"issuer_url":                    aws.StringValue(apiObject.IssuerUrl),
+		"jwks_retrieval_option":         aws.StringValue(apiObject.JwksRetrievalOption),
+	}
+
+	return tfMap
+}
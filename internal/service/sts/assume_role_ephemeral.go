@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// @EphemeralResource(name="Assume Role")
+func newEphemeralAssumeRole(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralAssumeRole{}, nil
+}
+
+type ephemeralAssumeRole struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralAssumeRole) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_sts_assume_role"
+}
+
+func (e *ephemeralAssumeRole) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"assumed_role_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"assumed_role_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(900, 43200),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				Computed: true,
+			},
+			"external_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"policy": schema.StringAttribute{
+				Optional: true,
+			},
+			"policy_arns": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"role_session_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"session_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"source_identity": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralAssumeRole) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data ephemeralAssumeRoleData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().STSConn(ctx)
+
+	roleARN := data.RoleARN.ValueARN().String()
+	if data.RoleSessionName.IsNull() {
+		data.RoleSessionName = types.StringValue(sdkid.PrefixedUniqueId("terraform-"))
+	}
+
+	input := &sts.AssumeRoleInput{
+		DurationSeconds: flex.Int64FromFramework(ctx, data.DurationSeconds),
+		ExternalId:      flex.StringFromFramework(ctx, data.ExternalID),
+		Policy:          flex.StringFromFramework(ctx, data.Policy),
+		RoleArn:         aws.String(roleARN),
+		RoleSessionName: flex.StringFromFramework(ctx, data.RoleSessionName),
+		SourceIdentity:  flex.StringFromFramework(ctx, data.SourceIdentity),
+	}
+
+	for _, v := range flex.ExpandFrameworkStringList(ctx, data.PolicyARNs) {
+		input.PolicyArns = append(input.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: v,
+		})
+	}
+
+	output, err := conn.AssumeRoleWithContext(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("assuming IAM Role (%s)", roleARN), err.Error())
+
+		return
+	}
+
+	credentials := output.Credentials
+	data.AccessKeyID = flex.StringToFramework(ctx, credentials.AccessKeyId)
+	data.AssumedRoleARN = flex.StringToFramework(ctx, output.AssumedRoleUser.Arn)
+	data.AssumedRoleID = flex.StringToFramework(ctx, output.AssumedRoleUser.AssumedRoleId)
+	data.Expiration = types.StringValue(aws.TimeValue(credentials.Expiration).Format(time.RFC3339))
+	data.SecretAccessKey = flex.StringToFramework(ctx, credentials.SecretAccessKey)
+	data.SessionToken = flex.StringToFramework(ctx, credentials.SessionToken)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type ephemeralAssumeRoleData struct {
+	AccessKeyID     types.String `tfsdk:"access_key_id"`
+	AssumedRoleARN  types.String `tfsdk:"assumed_role_arn"`
+	AssumedRoleID   types.String `tfsdk:"assumed_role_id"`
+	DurationSeconds types.Int64  `tfsdk:"duration_seconds"`
+	Expiration      types.String `tfsdk:"expiration"`
+	ExternalID      types.String `tfsdk:"external_id"`
+	Policy          types.String `tfsdk:"policy"`
+	PolicyARNs      types.List   `tfsdk:"policy_arns"`
+	RoleARN         fwtypes.ARN  `tfsdk:"role_arn"`
+	RoleSessionName types.String `tfsdk:"role_session_name"`
+	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+	SessionToken    types.String `tfsdk:"session_token"`
+	SourceIdentity  types.String `tfsdk:"source_identity"`
+}
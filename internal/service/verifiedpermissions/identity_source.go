@@ -0,0 +1,388 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	tfflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Identity Source")
+func newResourceIdentitySource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceIdentitySource{}, nil
+}
+
+type resourceIdentitySource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceIdentitySource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_identity_source"
+}
+
+func (r *resourceIdentitySource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"identity_source_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_entity_type": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"configuration": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"cognito_user_pool_configuration": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"client_ids": schema.ListAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"user_pool_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceIdentitySource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	cognitoUserPoolConfiguration, diags := expandCognitoUserPoolConfiguration(ctx, data.Configuration)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policyStoreID := data.PolicyStoreID.ValueString()
+	input := &verifiedpermissions.CreateIdentitySourceInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Configuration: &awstypes.ConfigurationMemberCognitoUserPoolConfiguration{
+			Value: cognitoUserPoolConfiguration,
+		},
+		PolicyStoreId:       aws.String(policyStoreID),
+		PrincipalEntityType: flex.StringFromFramework(ctx, data.PrincipalEntityType),
+	}
+
+	output, err := conn.CreateIdentitySource(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Verified Permissions Identity Source (%s)", policyStoreID), err.Error())
+
+		return
+	}
+
+	identitySourceID := aws.ToString(output.IdentitySourceId)
+	id, err := tfflex.FlattenResourceId([]string{policyStoreID, identitySourceID}, identitySourceResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Verified Permissions Identity Source", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(id)
+	data.IdentitySourceID = types.StringValue(identitySourceID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceIdentitySource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := tfflex.ExpandResourceId(data.ID.ValueString(), identitySourceResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	policyStoreID, identitySourceID := parts[0], parts[1]
+	output, err := findIdentitySourceByTwoPartKey(ctx, conn, policyStoreID, identitySourceID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Permissions Identity Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	configuration, diags := flattenIdentitySourceDetails(ctx, output.Details)
+	response.Diagnostics.Append(diags...)
+	data.Configuration = configuration
+	data.IdentitySourceID = flex.StringToFramework(ctx, output.IdentitySourceId)
+	data.PolicyStoreID = flex.StringToFramework(ctx, output.PolicyStoreId)
+	data.PrincipalEntityType = flex.StringToFramework(ctx, output.PrincipalEntityType)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceIdentitySource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	if !new.Configuration.Equal(old.Configuration) || !new.PrincipalEntityType.Equal(old.PrincipalEntityType) {
+		cognitoUserPoolConfiguration, diags := expandCognitoUserPoolConfiguration(ctx, new.Configuration)
+		response.Diagnostics.Append(diags...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &verifiedpermissions.UpdateIdentitySourceInput{
+			IdentitySourceId:    flex.StringFromFramework(ctx, new.IdentitySourceID),
+			PolicyStoreId:       flex.StringFromFramework(ctx, new.PolicyStoreID),
+			PrincipalEntityType: flex.StringFromFramework(ctx, new.PrincipalEntityType),
+			UpdateConfiguration: &awstypes.UpdateConfigurationMemberCognitoUserPoolConfiguration{
+				Value: awstypes.UpdateCognitoUserPoolConfiguration{
+					ClientIds:   cognitoUserPoolConfiguration.ClientIds,
+					UserPoolArn: cognitoUserPoolConfiguration.UserPoolArn,
+				},
+			},
+		}
+
+		_, err := conn.UpdateIdentitySource(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Verified Permissions Identity Source (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceIdentitySource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	tflog.Debug(ctx, "deleting Verified Permissions Identity Source", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeleteIdentitySource(ctx, &verifiedpermissions.DeleteIdentitySourceInput{
+		IdentitySourceId: flex.StringFromFramework(ctx, data.IdentitySourceID),
+		PolicyStoreId:    flex.StringFromFramework(ctx, data.PolicyStoreID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Verified Permissions Identity Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+const (
+	identitySourceResourceIDPartCount = 2
+)
+
+type resourceIdentitySourceData struct {
+	Configuration       types.List   `tfsdk:"configuration"`
+	ID                  types.String `tfsdk:"id"`
+	IdentitySourceID    types.String `tfsdk:"identity_source_id"`
+	PolicyStoreID       types.String `tfsdk:"policy_store_id"`
+	PrincipalEntityType types.String `tfsdk:"principal_entity_type"`
+}
+
+type identitySourceConfigurationData struct {
+	CognitoUserPoolConfiguration types.List `tfsdk:"cognito_user_pool_configuration"`
+}
+
+type cognitoUserPoolConfigurationData struct {
+	ClientIDs   types.List  `tfsdk:"client_ids"`
+	UserPoolARN fwtypes.ARN `tfsdk:"user_pool_arn"`
+}
+
+var (
+	cognitoUserPoolConfigurationAttrTypes = map[string]attr.Type{
+		"client_ids":    types.ListType{ElemType: types.StringType},
+		"user_pool_arn": fwtypes.ARNType,
+	}
+	identitySourceConfigurationAttrTypes = map[string]attr.Type{
+		"cognito_user_pool_configuration": types.ListType{ElemType: types.ObjectType{AttrTypes: cognitoUserPoolConfigurationAttrTypes}},
+	}
+)
+
+func expandCognitoUserPoolConfiguration(ctx context.Context, tfList types.List) (awstypes.CognitoUserPoolConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var apiObject awstypes.CognitoUserPoolConfiguration
+
+	var configurations []identitySourceConfigurationData
+	diags.Append(tfList.ElementsAs(ctx, &configurations, false)...)
+
+	if diags.HasError() || len(configurations) == 0 {
+		return apiObject, diags
+	}
+
+	var data []cognitoUserPoolConfigurationData
+	diags.Append(configurations[0].CognitoUserPoolConfiguration.ElementsAs(ctx, &data, false)...)
+
+	if diags.HasError() || len(data) == 0 {
+		return apiObject, diags
+	}
+
+	apiObject.ClientIds = flex.ExpandFrameworkStringValueList(ctx, data[0].ClientIDs)
+	apiObject.UserPoolArn = aws.String(data[0].UserPoolARN.ValueARN().String())
+
+	return apiObject, diags
+}
+
+func flattenIdentitySourceDetails(ctx context.Context, apiObject *awstypes.IdentitySourceDetails) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: identitySourceConfigurationAttrTypes}
+
+	if apiObject == nil {
+		return types.ListValueMust(elemType, []attr.Value{}), diags
+	}
+
+	userPoolARN, d := fwtypes.ARNType.ValueFromString(ctx, flex.StringToFramework(ctx, apiObject.UserPoolArn))
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return types.ListValueMust(elemType, []attr.Value{}), diags
+	}
+
+	cognitoUserPoolConfiguration, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: cognitoUserPoolConfigurationAttrTypes}, []cognitoUserPoolConfigurationData{{
+		ClientIDs:   flex.FlattenFrameworkStringValueList(ctx, apiObject.ClientIds),
+		UserPoolARN: userPoolARN.(fwtypes.ARN),
+	}})
+	diags.Append(d...)
+
+	list, d := types.ListValueFrom(ctx, elemType, []identitySourceConfigurationData{{
+		CognitoUserPoolConfiguration: cognitoUserPoolConfiguration,
+	}})
+	diags.Append(d...)
+
+	return list, diags
+}
+
+func findIdentitySourceByTwoPartKey(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, identitySourceID string) (*verifiedpermissions.GetIdentitySourceOutput, error) {
+	input := &verifiedpermissions.GetIdentitySourceInput{
+		IdentitySourceId: aws.String(identitySourceID),
+		PolicyStoreId:    aws.String(policyStoreID),
+	}
+
+	output, err := conn.GetIdentitySource(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
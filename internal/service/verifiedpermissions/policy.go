@@ -0,0 +1,529 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	tfflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Policy")
+func newResourcePolicy(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourcePolicy{}, nil
+}
+
+type resourcePolicy struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourcePolicy) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_policy"
+}
+
+func (r *resourcePolicy) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	entityIdentifierBlock := schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"entity_id": schema.StringAttribute{
+					Required: true,
+				},
+				"entity_type": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"policy_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"static": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"description": schema.StringAttribute{
+										Optional: true,
+									},
+									"statement": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplaceIf(requiresReplaceIfPolicyTypeChanges, "Replace on policy type change", "Replace on policy type change"),
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("static"),
+									path.MatchRelative().AtParent().AtName("template_linked"),
+								),
+							},
+						},
+						"template_linked": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"policy_template_id": schema.StringAttribute{
+										Required: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"principal": entityIdentifierBlock,
+									"resource":  entityIdentifierBlock,
+								},
+							},
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+// requiresReplaceIfPolicyTypeChanges forces replacement when a policy moves between static and template-linked,
+// as UpdatePolicy can only update the statement and description of a static policy.
+func requiresReplaceIfPolicyTypeChanges(ctx context.Context, request planmodifier.ListRequest, response *listplanmodifier.RequiresReplaceIfFuncResponse) {
+	response.RequiresReplace = request.StateValue.IsNull() || len(request.StateValue.Elements()) == 0 || request.PlanValue.IsNull() || len(request.PlanValue.Elements()) == 0
+}
+
+func (r *resourcePolicy) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourcePolicyData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	definition, diags := expandPolicyDefinition(ctx, data.Definition)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policyStoreID := data.PolicyStoreID.ValueString()
+	input := &verifiedpermissions.CreatePolicyInput{
+		ClientToken:   aws.String(id.UniqueId()),
+		Definition:    definition,
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	output, err := conn.CreatePolicy(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Verified Permissions Policy (%s)", policyStoreID), err.Error())
+
+		return
+	}
+
+	policyID := aws.ToString(output.PolicyId)
+	id, err := tfflex.FlattenResourceId([]string{policyStoreID, policyID}, policyResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Verified Permissions Policy", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(id)
+	data.PolicyID = types.StringValue(policyID)
+	data.PolicyType = flex.StringValueToFramework(ctx, output.PolicyType)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourcePolicy) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourcePolicyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := tfflex.ExpandResourceId(data.ID.ValueString(), policyResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	policyStoreID, policyID := parts[0], parts[1]
+	output, err := findPolicyByTwoPartKey(ctx, conn, policyStoreID, policyID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Permissions Policy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	definition, diags := flattenPolicyDefinition(ctx, output.Definition)
+	response.Diagnostics.Append(diags...)
+	data.Definition = definition
+	data.PolicyID = flex.StringToFramework(ctx, output.PolicyId)
+	data.PolicyStoreID = flex.StringToFramework(ctx, output.PolicyStoreId)
+	data.PolicyType = flex.StringValueToFramework(ctx, output.PolicyType)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourcePolicy) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourcePolicyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	if !new.Definition.Equal(old.Definition) {
+		var definitions []policyDefinitionData
+		response.Diagnostics.Append(new.Definition.ElementsAs(ctx, &definitions, false)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		var statics []staticPolicyDefinitionData
+		if len(definitions) > 0 {
+			response.Diagnostics.Append(definitions[0].Static.ElementsAs(ctx, &statics, false)...)
+		}
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if len(statics) > 0 {
+			input := &verifiedpermissions.UpdatePolicyInput{
+				Definition: &awstypes.UpdatePolicyDefinitionMemberStatic{
+					Value: awstypes.UpdateStaticPolicyDefinition{
+						Description: flex.StringFromFramework(ctx, statics[0].Description),
+						Statement:   flex.StringFromFramework(ctx, statics[0].Statement),
+					},
+				},
+				PolicyId:      flex.StringFromFramework(ctx, new.PolicyID),
+				PolicyStoreId: flex.StringFromFramework(ctx, new.PolicyStoreID),
+			}
+
+			_, err := conn.UpdatePolicy(ctx, input)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("updating Verified Permissions Policy (%s)", new.ID.ValueString()), err.Error())
+
+				return
+			}
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourcePolicy) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourcePolicyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	tflog.Debug(ctx, "deleting Verified Permissions Policy", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeletePolicy(ctx, &verifiedpermissions.DeletePolicyInput{
+		PolicyId:      flex.StringFromFramework(ctx, data.PolicyID),
+		PolicyStoreId: flex.StringFromFramework(ctx, data.PolicyStoreID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Verified Permissions Policy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+const (
+	policyResourceIDPartCount = 2
+)
+
+type resourcePolicyData struct {
+	Definition    types.List   `tfsdk:"definition"`
+	ID            types.String `tfsdk:"id"`
+	PolicyID      types.String `tfsdk:"policy_id"`
+	PolicyStoreID types.String `tfsdk:"policy_store_id"`
+	PolicyType    types.String `tfsdk:"policy_type"`
+}
+
+type policyDefinitionData struct {
+	Static         types.List `tfsdk:"static"`
+	TemplateLinked types.List `tfsdk:"template_linked"`
+}
+
+type staticPolicyDefinitionData struct {
+	Description types.String `tfsdk:"description"`
+	Statement   types.String `tfsdk:"statement"`
+}
+
+type templateLinkedPolicyDefinitionData struct {
+	PolicyTemplateID types.String `tfsdk:"policy_template_id"`
+	Principal        types.List   `tfsdk:"principal"`
+	Resource         types.List   `tfsdk:"resource"`
+}
+
+type entityIdentifierData struct {
+	EntityID   types.String `tfsdk:"entity_id"`
+	EntityType types.String `tfsdk:"entity_type"`
+}
+
+var (
+	entityIdentifierAttrTypes = map[string]attr.Type{
+		"entity_id":   types.StringType,
+		"entity_type": types.StringType,
+	}
+	staticPolicyDefinitionAttrTypes = map[string]attr.Type{
+		"description": types.StringType,
+		"statement":   types.StringType,
+	}
+	templateLinkedPolicyDefinitionAttrTypes = map[string]attr.Type{
+		"policy_template_id": types.StringType,
+		"principal":          types.ListType{ElemType: types.ObjectType{AttrTypes: entityIdentifierAttrTypes}},
+		"resource":           types.ListType{ElemType: types.ObjectType{AttrTypes: entityIdentifierAttrTypes}},
+	}
+	policyDefinitionAttrTypes = map[string]attr.Type{
+		"static":          types.ListType{ElemType: types.ObjectType{AttrTypes: staticPolicyDefinitionAttrTypes}},
+		"template_linked": types.ListType{ElemType: types.ObjectType{AttrTypes: templateLinkedPolicyDefinitionAttrTypes}},
+	}
+)
+
+func expandPolicyDefinition(ctx context.Context, tfList types.List) (awstypes.PolicyDefinition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var data []policyDefinitionData
+	diags.Append(tfList.ElementsAs(ctx, &data, false)...)
+
+	if diags.HasError() || len(data) == 0 {
+		return nil, diags
+	}
+
+	var statics []staticPolicyDefinitionData
+	diags.Append(data[0].Static.ElementsAs(ctx, &statics, false)...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if len(statics) > 0 {
+		return &awstypes.PolicyDefinitionMemberStatic{
+			Value: awstypes.StaticPolicyDefinition{
+				Description: flex.StringFromFramework(ctx, statics[0].Description),
+				Statement:   flex.StringFromFramework(ctx, statics[0].Statement),
+			},
+		}, diags
+	}
+
+	var templateLinkeds []templateLinkedPolicyDefinitionData
+	diags.Append(data[0].TemplateLinked.ElementsAs(ctx, &templateLinkeds, false)...)
+
+	if diags.HasError() || len(templateLinkeds) == 0 {
+		return nil, diags
+	}
+
+	principal, d := expandEntityIdentifier(ctx, templateLinkeds[0].Principal)
+	diags.Append(d...)
+	resource, d := expandEntityIdentifier(ctx, templateLinkeds[0].Resource)
+	diags.Append(d...)
+
+	return &awstypes.PolicyDefinitionMemberTemplateLinked{
+		Value: awstypes.TemplateLinkedPolicyDefinition{
+			PolicyTemplateId: flex.StringFromFramework(ctx, templateLinkeds[0].PolicyTemplateID),
+			Principal:        principal,
+			Resource:         resource,
+		},
+	}, diags
+}
+
+func expandEntityIdentifier(ctx context.Context, tfList types.List) (*awstypes.EntityIdentifier, diag.Diagnostics) {
+	var data []entityIdentifierData
+	diags := tfList.ElementsAs(ctx, &data, false)
+
+	if diags.HasError() || len(data) == 0 {
+		return nil, diags
+	}
+
+	return &awstypes.EntityIdentifier{
+		EntityId:   flex.StringFromFramework(ctx, data[0].EntityID),
+		EntityType: flex.StringFromFramework(ctx, data[0].EntityType),
+	}, diags
+}
+
+func flattenPolicyDefinition(ctx context.Context, apiObject awstypes.PolicyDefinitionDetail) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: policyDefinitionAttrTypes}
+	staticElemType := types.ObjectType{AttrTypes: staticPolicyDefinitionAttrTypes}
+	templateLinkedElemType := types.ObjectType{AttrTypes: templateLinkedPolicyDefinitionAttrTypes}
+
+	data := policyDefinitionData{
+		Static:         types.ListValueMust(staticElemType, []attr.Value{}),
+		TemplateLinked: types.ListValueMust(templateLinkedElemType, []attr.Value{}),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.PolicyDefinitionDetailMemberStatic:
+		static, d := types.ListValueFrom(ctx, staticElemType, []staticPolicyDefinitionData{{
+			Description: flex.StringValueToFramework(ctx, aws.ToString(v.Value.Description)),
+			Statement:   flex.StringToFramework(ctx, v.Value.Statement),
+		}})
+		diags.Append(d...)
+		data.Static = static
+
+	case *awstypes.PolicyDefinitionDetailMemberTemplateLinked:
+		principal, d := flattenEntityIdentifier(ctx, v.Value.Principal)
+		diags.Append(d...)
+		resource, d := flattenEntityIdentifier(ctx, v.Value.Resource)
+		diags.Append(d...)
+		templateLinked, d := types.ListValueFrom(ctx, templateLinkedElemType, []templateLinkedPolicyDefinitionData{{
+			PolicyTemplateID: flex.StringToFramework(ctx, v.Value.PolicyTemplateId),
+			Principal:        principal,
+			Resource:         resource,
+		}})
+		diags.Append(d...)
+		data.TemplateLinked = templateLinked
+
+	default:
+		return types.ListValueMust(elemType, []attr.Value{}), diags
+	}
+
+	list, d := types.ListValueFrom(ctx, elemType, []policyDefinitionData{data})
+	diags.Append(d...)
+
+	return list, diags
+}
+
+func flattenEntityIdentifier(ctx context.Context, apiObject *awstypes.EntityIdentifier) (types.List, diag.Diagnostics) {
+	elemType := types.ObjectType{AttrTypes: entityIdentifierAttrTypes}
+
+	if apiObject == nil {
+		return types.ListValueMust(elemType, []attr.Value{}), nil
+	}
+
+	return types.ListValueFrom(ctx, elemType, []entityIdentifierData{{
+		EntityID:   flex.StringToFramework(ctx, apiObject.EntityId),
+		EntityType: flex.StringToFramework(ctx, apiObject.EntityType),
+	}})
+}
+
+func findPolicyByTwoPartKey(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, policyID string) (*verifiedpermissions.GetPolicyOutput, error) {
+	input := &verifiedpermissions.GetPolicyInput{
+		PolicyId:      aws.String(policyID),
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	output, err := conn.GetPolicy(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Definition == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,299 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// emptySchemaDefinition is written on delete, as the API has no way to remove a policy store's schema.
+const emptySchemaDefinition = "{}"
+
+// @FrameworkResource(name="Schema")
+func newResourceSchema(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceSchema{}, nil
+}
+
+type resourceSchema struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceSchema) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_schema"
+}
+
+func (r *resourceSchema) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"namespaces": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							CustomType: fwtypes.JSONType,
+							Required:   true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSchema) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceSchemaData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	definition, diags := expandSchemaDefinition(ctx, data.Definition)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policyStoreID := data.PolicyStoreID.ValueString()
+	input := &verifiedpermissions.PutSchemaInput{
+		Definition:    definition,
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	output, err := conn.PutSchema(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Verified Permissions Schema (%s)", policyStoreID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(policyStoreID)
+	data.Namespaces = flex.FlattenFrameworkStringValueSet(ctx, output.Namespaces)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSchema) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceSchemaData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	output, err := findSchemaByPolicyStoreID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Permissions Schema (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	definition, diags := flattenSchemaDefinition(ctx, output.Schema)
+	response.Diagnostics.Append(diags...)
+	data.Definition = definition
+	data.PolicyStoreID = flex.StringToFramework(ctx, output.PolicyStoreId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSchema) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceSchemaData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	if !new.Definition.Equal(old.Definition) {
+		definition, diags := expandSchemaDefinition(ctx, new.Definition)
+		response.Diagnostics.Append(diags...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &verifiedpermissions.PutSchemaInput{
+			Definition:    definition,
+			PolicyStoreId: flex.StringFromFramework(ctx, new.ID),
+		}
+
+		output, err := conn.PutSchema(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Verified Permissions Schema (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.Namespaces = flex.FlattenFrameworkStringValueSet(ctx, output.Namespaces)
+	} else {
+		new.Namespaces = old.Namespaces
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceSchema) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceSchemaData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	tflog.Debug(ctx, "deleting Verified Permissions Schema", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.PutSchema(ctx, &verifiedpermissions.PutSchemaInput{
+		Definition: &awstypes.SchemaDefinitionMemberCedarJson{
+			Value: emptySchemaDefinition,
+		},
+		PolicyStoreId: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Verified Permissions Schema (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type resourceSchemaData struct {
+	Definition    types.List   `tfsdk:"definition"`
+	ID            types.String `tfsdk:"id"`
+	Namespaces    types.Set    `tfsdk:"namespaces"`
+	PolicyStoreID types.String `tfsdk:"policy_store_id"`
+}
+
+type schemaDefinitionData struct {
+	Value fwtypes.JSON `tfsdk:"value"`
+}
+
+var schemaDefinitionAttrTypes = map[string]attr.Type{
+	"value": fwtypes.JSONType,
+}
+
+func expandSchemaDefinition(ctx context.Context, tfList types.List) (awstypes.SchemaDefinition, diag.Diagnostics) {
+	var data []schemaDefinitionData
+	diags := tfList.ElementsAs(ctx, &data, false)
+
+	if diags.HasError() || len(data) == 0 {
+		return nil, diags
+	}
+
+	return &awstypes.SchemaDefinitionMemberCedarJson{
+		Value: data[0].Value.ValueString(),
+	}, diags
+}
+
+func flattenSchemaDefinition(ctx context.Context, schema *string) (types.List, diag.Diagnostics) {
+	elemType := types.ObjectType{AttrTypes: schemaDefinitionAttrTypes}
+
+	if schema == nil {
+		return types.ListValueMust(elemType, []attr.Value{}), nil
+	}
+
+	return types.ListValueFrom(ctx, elemType, []schemaDefinitionData{{
+		Value: fwtypes.JSONValue(aws.ToString(schema)),
+	}})
+}
+
+func findSchemaByPolicyStoreID(ctx context.Context, conn *verifiedpermissions.Client, id string) (*verifiedpermissions.GetSchemaOutput, error) {
+	input := &verifiedpermissions.GetSchemaInput{
+		PolicyStoreId: aws.String(id),
+	}
+
+	output, err := conn.GetSchema(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || aws.ToString(output.Schema) == emptySchemaDefinition {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -19,12 +19,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_vpclattice_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpclattice_service", &resource.Sweeper{
 		Name: "aws_vpclattice_service",
 		F:    sweepServices,
 	})
 
-	resource.AddTestSweepers("aws_vpclattice_service_network", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpclattice_service_network", &resource.Sweeper{
 		Name: "aws_vpclattice_service_network",
 		F:    sweepServiceNetworks,
 		Dependencies: []string{
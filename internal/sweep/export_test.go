@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+// Exports for use in tests only.
+var (
+	SweeperLevels = func(typeNames []string) ([][]string, error) { return sweeperLevels(sweepers, typeNames) }
+)
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// creationTimeAttributes are the names of the attributes that commonly hold a resource's RFC 3339 creation time.
+var creationTimeAttributes = []string{
+	"create_date",
+	"create_time",
+	"created_at",
+	"created_date",
+	"created_time",
+	"creation_date",
+	"creation_time",
+	"launch_time",
+}
+
+// AttributeGetter returns the value of a resource's attribute, or nil if the resource has no such attribute.
+// String attributes are returned as string and map attributes as any type supported by tftags.New.
+type AttributeGetter func(name string) any
+
+// Describe returns a description of an existing resource from its attribute values.
+// Context must have been passed to the resource's Read function enhanced with tagging information (see tftags.NewContext)
+// so that tags returned by transparent tagging are used.
+// Tags not otherwise available are looked up from the resource's ARN using the Resource Groups Tagging API.
+// Resources whose type has no `tags` or `tags_all` attribute have no tag data.
+func Describe(ctx context.Context, meta *conns.AWSClient, get AttributeGetter) Resource {
+	var r Resource
+
+	for _, name := range creationTimeAttributes {
+		if v, ok := get(name).(string); ok && v != "" {
+			if t, err := time.Parse(time.RFC3339, v); err == nil {
+				r.CreationTime = &t
+				break
+			}
+		}
+	}
+
+	taggable := false
+	for _, name := range []string{"tags_all", "tags"} {
+		if v := get(name); v != nil {
+			taggable = true
+
+			if tags := tftags.New(ctx, v).Map(); len(tags) > 0 {
+				r.Tags = tags
+				break
+			}
+		}
+	}
+
+	// Tags stays nil for resources that can't be tagged, so that tag filters never match them.
+	if r.Tags == nil && taggable {
+		if inContext, ok := tftags.FromContext(ctx); ok && inContext.TagsOut.IsSome() {
+			r.Tags = inContext.TagsOut.UnwrapOrDefault().Map()
+		} else if v, ok := get("arn").(string); ok && v != "" {
+			tags, err := lookupTags(ctx, meta, v)
+
+			if err != nil {
+				tflog.Warn(ctx, "Looking up resource tags", map[string]any{
+					"error": err.Error(),
+				})
+			} else {
+				r.Tags = tags
+			}
+		}
+	}
+
+	if v, ok := get("name").(string); ok && v != "" {
+		r.Name = v
+	} else if v, ok := r.Tags["Name"]; ok {
+		r.Name = v
+	}
+
+	return r
+}
+
+// lookupTags returns the tags of the resource with the specified ARN.
+func lookupTags(ctx context.Context, meta *conns.AWSClient, arn string) (map[string]string, error) {
+	conn := meta.ResourceGroupsTaggingAPIConn(ctx)
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceARNList: aws.StringSlice([]string{arn}),
+	}
+
+	output, err := conn.GetResourcesWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]string)
+
+	// Resources that have never been tagged are not returned.
+	for _, v := range output.ResourceTagMappingList {
+		for _, v := range v.Tags {
+			tags[aws.StringValue(v.Key)] = aws.StringValue(v.Value)
+		}
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDescribe(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		Name         string
+		Attributes   map[string]any
+		ExpectedName string
+		ExpectedTags map[string]string
+	}{
+		{
+			Name:         "tagged",
+			Attributes:   map[string]any{"name": "tf-acc-test-1", "tags_all": map[string]any{"Owner": "ci"}},
+			ExpectedName: "tf-acc-test-1",
+			ExpectedTags: map[string]string{"Owner": "ci"},
+		},
+		{
+			Name:         "name tag",
+			Attributes:   map[string]any{"tags": map[string]any{"Name": "tf-acc-test-2"}},
+			ExpectedName: "tf-acc-test-2",
+			ExpectedTags: map[string]string{"Name": "tf-acc-test-2"},
+		},
+		{
+			Name:         "not taggable",
+			Attributes:   map[string]any{"name": "tf-acc-test-3"},
+			ExpectedName: "tf-acc-test-3",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Describe(ctx, nil, func(name string) any {
+				return testCase.Attributes[name]
+			})
+
+			if got.Name != testCase.ExpectedName {
+				t.Errorf("got name %q, expected %q", got.Name, testCase.ExpectedName)
+			}
+
+			if testCase.ExpectedTags == nil {
+				if got.Tags != nil {
+					t.Errorf("got tags %v, expected no tag data", got.Tags)
+				}
+				return
+			}
+
+			if len(got.Tags) != len(testCase.ExpectedTags) {
+				t.Fatalf("got tags %v, expected %v", got.Tags, testCase.ExpectedTags)
+			}
+			for k, v := range testCase.ExpectedTags {
+				if got.Tags[k] != v {
+					t.Errorf("got tags %v, expected %v", got.Tags, testCase.ExpectedTags)
+				}
+			}
+		})
+	}
+}
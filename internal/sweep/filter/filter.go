@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package filter selects the resources deleted by sweepers,
+// so that sweepers can be run safely in accounts containing long-lived resources.
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+// Options configures which resources sweepers delete.
+type Options struct {
+	// DryRun lists the resources that would be deleted without deleting them.
+	DryRun bool
+	// IncludeTags, if set, restricts deletion to resources with all of these tags.
+	// An empty value matches any value.
+	IncludeTags map[string]string
+	// ExcludeTags prevents deletion of resources with any of these tags.
+	// An empty value matches any value.
+	ExcludeTags map[string]string
+	// IncludeNamePrefixes, if set, restricts deletion to resources whose name has one of these prefixes.
+	IncludeNamePrefixes []string
+	// ExcludeNamePrefixes prevents deletion of resources whose name has any of these prefixes.
+	ExcludeNamePrefixes []string
+	// MinAge, if set, restricts deletion to resources created at least this long ago.
+	MinAge time.Duration
+}
+
+// Active returns whether the options can prevent any resource being deleted.
+func (o *Options) Active() bool {
+	return o.DryRun || o.Filtered()
+}
+
+// Filtered returns whether resources must be inspected before being deleted.
+func (o *Options) Filtered() bool {
+	return o.filtersTags() || o.filtersNames() || o.MinAge > 0
+}
+
+func (o *Options) filtersTags() bool {
+	return len(o.IncludeTags) > 0 || len(o.ExcludeTags) > 0
+}
+
+func (o *Options) filtersNames() bool {
+	return len(o.IncludeNamePrefixes) > 0 || len(o.ExcludeNamePrefixes) > 0
+}
+
+// Resource describes an existing resource.
+type Resource struct {
+	// CreationTime is nil if the resource's creation time isn't known.
+	CreationTime *time.Time
+	// Name is empty if the resource's name isn't known.
+	Name string
+	// Tags is nil if there is no tag data for the resource, either because its type can't be tagged
+	// or because its tags couldn't be read.
+	Tags map[string]string
+}
+
+// Evaluate returns a SkippedError if the resource must not be deleted.
+// A resource with no tag data never matches IncludeTags, and as ExcludeTags can't protect it,
+// it is never deleted while any tag filter is set.
+func (o *Options) Evaluate(r Resource) error {
+	if o.filtersTags() && r.Tags == nil {
+		return skipped("no tag data")
+	}
+
+	for k, v := range o.IncludeTags {
+		if !hasTag(r.Tags, k, v) {
+			return skipped("missing tag %s", formatTag(k, v))
+		}
+	}
+
+	for k, v := range o.ExcludeTags {
+		if hasTag(r.Tags, k, v) {
+			return skipped("excluded tag %s", formatTag(k, v))
+		}
+	}
+
+	if o.filtersNames() && r.Name == "" {
+		return skipped("name unknown")
+	}
+
+	if len(o.IncludeNamePrefixes) > 0 && !hasPrefix(r.Name, o.IncludeNamePrefixes) {
+		return skipped("name %q not included", r.Name)
+	}
+
+	if len(o.ExcludeNamePrefixes) > 0 && hasPrefix(r.Name, o.ExcludeNamePrefixes) {
+		return skipped("name %q excluded", r.Name)
+	}
+
+	if o.MinAge > 0 {
+		if r.CreationTime == nil {
+			return skipped("creation time unknown")
+		}
+
+		if age := time.Since(*r.CreationTime); age < o.MinAge {
+			return skipped("created %s ago", age.Round(time.Second))
+		}
+	}
+
+	if o.DryRun {
+		return skipped("dry run")
+	}
+
+	return nil
+}
+
+func hasTag(tags map[string]string, key, value string) bool {
+	v, ok := tags[key]
+
+	return ok && (value == "" || v == value)
+}
+
+func formatTag(key, value string) string {
+	if value == "" {
+		return key
+	}
+
+	return key + "=" + value
+}
+
+func hasPrefix(s string, prefixes []string) bool {
+	for _, v := range prefixes {
+		if strings.HasPrefix(s, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SkippedError is returned when sweeping a resource that the options prevent from being deleted.
+type SkippedError struct {
+	Reason string
+}
+
+func (e *SkippedError) Error() string {
+	return "skipped: " + e.Reason
+}
+
+func skipped(format string, a ...any) error {
+	return &SkippedError{Reason: fmt.Sprintf(format, a...)}
+}
+
+// IsSkipped returns whether the error indicates that a resource was deliberately not deleted.
+func IsSkipped(err error) bool {
+	var e *SkippedError
+
+	return errors.As(err, &e)
+}
+
+var (
+	current     *Options
+	currentErr  error
+	currentOnce sync.Once
+)
+
+// Current returns the options configured via environment variables.
+func Current() (*Options, error) {
+	currentOnce.Do(func() {
+		current, currentErr = fromEnv()
+	})
+
+	return current, currentErr
+}
+
+func fromEnv() (*Options, error) {
+	var o Options
+	var err error
+
+	if v := os.Getenv(envvar.SweepDryRun); v != "" {
+		if o.DryRun, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepDryRun, err)
+		}
+	}
+
+	if o.IncludeTags, err = parseTags(envvar.SweepIncludeTags); err != nil {
+		return nil, err
+	}
+
+	if o.ExcludeTags, err = parseTags(envvar.SweepExcludeTags); err != nil {
+		return nil, err
+	}
+
+	o.IncludeNamePrefixes = parseList(envvar.SweepIncludeNamePrefixes)
+	o.ExcludeNamePrefixes = parseList(envvar.SweepExcludeNamePrefixes)
+
+	if v := os.Getenv(envvar.SweepMinAge); v != "" {
+		if o.MinAge, err = time.ParseDuration(v); err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepMinAge, err)
+		}
+	}
+
+	return &o, nil
+}
+
+// parseTags parses a comma-separated list of `key=value` or `key` elements.
+func parseTags(name string) (map[string]string, error) {
+	list := parseList(name)
+
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	tags := make(map[string]string, len(list))
+	for _, v := range list {
+		k, v, _ := strings.Cut(v, "=")
+		if k == "" {
+			return nil, fmt.Errorf("environment variable %s: empty tag key", name)
+		}
+		tags[k] = v
+	}
+
+	return tags, nil
+}
+
+func parseList(name string) []string {
+	var list []string
+
+	for _, v := range strings.Split(os.Getenv(name), ",") {
+		if v := strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+
+	return list
+}
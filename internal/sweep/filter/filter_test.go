@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOptionsEvaluate(t *testing.T) {
+	t.Parallel()
+
+	old := time.Now().Add(-48 * time.Hour)
+	recent := time.Now().Add(-1 * time.Hour)
+
+	testCases := []struct {
+		Name           string
+		Options        Options
+		Resource       Resource
+		ExpectedReason string
+	}{
+		{
+			Name:     "no options",
+			Resource: Resource{},
+		},
+		{
+			Name:           "dry run",
+			Options:        Options{DryRun: true},
+			Resource:       Resource{},
+			ExpectedReason: "dry run",
+		},
+		{
+			Name:     "include tags",
+			Options:  Options{IncludeTags: map[string]string{"Owner": "ci", "Ephemeral": ""}},
+			Resource: Resource{Tags: map[string]string{"Owner": "ci", "Ephemeral": "yes"}},
+		},
+		{
+			Name:           "include tags missing",
+			Options:        Options{IncludeTags: map[string]string{"Owner": "ci"}},
+			Resource:       Resource{Tags: map[string]string{"Owner": "alice"}},
+			ExpectedReason: "missing tag Owner=ci",
+		},
+		{
+			Name:           "include tags no tag data",
+			Options:        Options{IncludeTags: map[string]string{"Owner": "ci"}},
+			Resource:       Resource{},
+			ExpectedReason: "no tag data",
+		},
+		{
+			Name:           "exclude tags",
+			Options:        Options{ExcludeTags: map[string]string{"DoNotDelete": ""}},
+			Resource:       Resource{Tags: map[string]string{"DoNotDelete": "true"}},
+			ExpectedReason: "excluded tag DoNotDelete",
+		},
+		{
+			Name:     "exclude tags not present",
+			Options:  Options{ExcludeTags: map[string]string{"DoNotDelete": ""}},
+			Resource: Resource{Tags: map[string]string{}},
+		},
+		{
+			Name:           "exclude tags no tag data",
+			Options:        Options{ExcludeTags: map[string]string{"DoNotDelete": ""}},
+			Resource:       Resource{},
+			ExpectedReason: "no tag data",
+		},
+		{
+			Name:     "include name prefixes",
+			Options:  Options{IncludeNamePrefixes: []string{"tf-acc-test", "tf-test"}},
+			Resource: Resource{Name: "tf-test-123"},
+		},
+		{
+			Name:           "include name prefixes not included",
+			Options:        Options{IncludeNamePrefixes: []string{"tf-acc-test"}},
+			Resource:       Resource{Name: "production"},
+			ExpectedReason: `name "production" not included`,
+		},
+		{
+			Name:           "include name prefixes unknown",
+			Options:        Options{IncludeNamePrefixes: []string{"tf-acc-test"}},
+			Resource:       Resource{},
+			ExpectedReason: "name unknown",
+		},
+		{
+			Name:           "exclude name prefixes",
+			Options:        Options{ExcludeNamePrefixes: []string{"shared-"}},
+			Resource:       Resource{Name: "shared-vpc"},
+			ExpectedReason: `name "shared-vpc" excluded`,
+		},
+		{
+			Name:     "exclude name prefixes not excluded",
+			Options:  Options{ExcludeNamePrefixes: []string{"shared-"}},
+			Resource: Resource{Name: "tf-acc-test-123"},
+		},
+		{
+			Name:           "exclude name prefixes unknown",
+			Options:        Options{ExcludeNamePrefixes: []string{"shared-"}},
+			Resource:       Resource{},
+			ExpectedReason: "name unknown",
+		},
+		{
+			Name:     "min age",
+			Options:  Options{MinAge: 24 * time.Hour},
+			Resource: Resource{CreationTime: &old},
+		},
+		{
+			Name:           "min age too recent",
+			Options:        Options{MinAge: 24 * time.Hour},
+			Resource:       Resource{CreationTime: &recent},
+			ExpectedReason: "created 1h0m0s ago",
+		},
+		{
+			Name:           "min age unknown",
+			Options:        Options{MinAge: 24 * time.Hour},
+			Resource:       Resource{},
+			ExpectedReason: "creation time unknown",
+		},
+		{
+			Name:           "filtered dry run",
+			Options:        Options{DryRun: true, IncludeNamePrefixes: []string{"tf-acc-test"}},
+			Resource:       Resource{Name: "tf-acc-test-123"},
+			ExpectedReason: "dry run",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.Options.Evaluate(testCase.Resource)
+
+			if testCase.ExpectedReason == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if !IsSkipped(err) {
+				t.Fatalf("expected skipped error, got %v", err)
+			}
+
+			if got := err.(*SkippedError).Reason; got != testCase.ExpectedReason {
+				t.Errorf("got reason %q, expected %q", got, testCase.ExpectedReason)
+			}
+		})
+	}
+}
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv("TF_AWS_SWEEP_DRY_RUN", "true")
+	t.Setenv("TF_AWS_SWEEP_INCLUDE_TAGS", "Owner=ci, Ephemeral")
+	t.Setenv("TF_AWS_SWEEP_EXCLUDE_NAME_PREFIXES", "shared-,prod-")
+	t.Setenv("TF_AWS_SWEEP_MIN_AGE", "24h")
+
+	got, err := fromEnv()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !got.DryRun {
+		t.Error("expected dry run")
+	}
+	if v, ok := got.IncludeTags["Owner"]; !ok || v != "ci" {
+		t.Errorf("got include tag Owner=%q", v)
+	}
+	if v, ok := got.IncludeTags["Ephemeral"]; !ok || v != "" {
+		t.Errorf("got include tag Ephemeral=%q", v)
+	}
+	if len(got.ExcludeTags) != 0 {
+		t.Errorf("got exclude tags %v", got.ExcludeTags)
+	}
+	if len(got.ExcludeNamePrefixes) != 2 || got.ExcludeNamePrefixes[1] != "prod-" {
+		t.Errorf("got exclude name prefixes %v", got.ExcludeNamePrefixes)
+	}
+	if got.MinAge != 24*time.Hour {
+		t.Errorf("got minimum age %s", got.MinAge)
+	}
+
+	t.Setenv("TF_AWS_SWEEP_MIN_AGE", "a day")
+
+	if _, err := fromEnv(); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+)
+
+type deleteContextKeyType int
+
+var deleteContextKey deleteContextKeyType
+
+// NewDeleteContext returns a Context for deleting a resource that the options allow to be deleted.
+// API calls made with any other Context are refused by the guards unless they only read.
+func NewDeleteContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, deleteContextKey, true)
+}
+
+func isDeleteContext(ctx context.Context) bool {
+	v, ok := ctx.Value(deleteContextKey).(bool)
+
+	return ok && v
+}
+
+// readOnlyOperationPrefixes are the prefixes of AWS API operation names that never modify resources.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+}
+
+// guard applies the current options to an API operation.
+func guard(ctx context.Context, service, operation string) error {
+	options, err := Current()
+
+	if err != nil {
+		return err
+	}
+
+	// Nothing is deleted while listing.
+	if Listing() {
+		options = &Options{DryRun: true}
+	}
+
+	return options.guard(ctx, service, operation)
+}
+
+// guard returns a SkippedError if any option is set and the API operation may modify a resource
+// but is not made with a Context from NewDeleteContext.
+// Sweepers that delete resources directly, without using sweep.NewSweepResource or framework.NewSweepResource,
+// therefore delete nothing while any option is set.
+func (o *Options) guard(ctx context.Context, service, operation string) error {
+	if !o.Active() || isDeleteContext(ctx) {
+		return nil
+	}
+
+	for _, v := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(operation, v) {
+			return nil
+		}
+	}
+
+	log.Printf("[INFO] Refusing %s %s outside a filtered sweep", service, operation)
+
+	return skipped("%s %s does not apply TF_AWS_SWEEP_* options", service, operation)
+}
+
+// SDKv1Handler returns a request handler that applies the guard to each AWS SDK for Go v1 API operation.
+func SDKv1Handler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "tfsweep.Guard",
+		Fn: func(r *request.Request) {
+			if err := guard(r.Context(), r.ClientInfo.ServiceID, r.Operation.Name); err != nil {
+				r.Error = err
+			}
+		},
+	}
+}
+
+// SDKv2Middleware returns an API option that applies the guard to each AWS SDK for Go v2 API operation.
+func SDKv2Middleware() func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("tfsweep.Guard", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			if err := guard(ctx, awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx)); err != nil {
+				return middleware.InitializeOutput{}, middleware.Metadata{}, err
+			}
+
+			return next.HandleInitialize(ctx, in)
+		}), middleware.After)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"context"
+	"testing"
+)
+
+func TestOptionsGuard(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		Name      string
+		Options   Options
+		Context   context.Context
+		Operation string
+		Expected  bool
+	}{
+		{
+			Name:      "no options",
+			Context:   ctx,
+			Operation: "DeleteVpc",
+		},
+		{
+			Name:      "read",
+			Options:   Options{DryRun: true},
+			Context:   ctx,
+			Operation: "DescribeVpcs",
+		},
+		{
+			Name:      "delete",
+			Options:   Options{DryRun: true},
+			Context:   ctx,
+			Operation: "DeleteVpc",
+			Expected:  true,
+		},
+		{
+			Name:      "modify",
+			Options:   Options{IncludeTags: map[string]string{"Owner": "ci"}},
+			Context:   ctx,
+			Operation: "DetachInternetGateway",
+			Expected:  true,
+		},
+		{
+			Name:      "delete context",
+			Options:   Options{IncludeTags: map[string]string{"Owner": "ci"}},
+			Context:   NewDeleteContext(ctx),
+			Operation: "DeleteVpc",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.Options.guard(testCase.Context, "EC2", testCase.Operation)
+
+			if got, expected := IsSkipped(err), testCase.Expected; got != expected {
+				t.Errorf("got skipped %t, expected %t (error: %v)", got, expected, err)
+			}
+		})
+	}
+}
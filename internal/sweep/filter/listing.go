@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Listed describes an existing resource recorded, instead of being deleted, while listing.
+type Listed struct {
+	// TypeName is the resource type, e.g. "aws_vpc". It is empty for Plugin SDK resources.
+	TypeName string
+	// Resource is the Plugin SDK resource, or nil for Plugin Framework resources.
+	Resource *schema.Resource
+	// Get returns the value of an attribute of the resource as read. The `id` attribute is always available.
+	Get AttributeGetter
+}
+
+var listing struct {
+	active bool
+	listed []Listed
+	mu     sync.Mutex
+}
+
+// StartListing switches sweepers to listing existing resources instead of deleting them.
+// Resources that would be deleted by sweep.NewSweepResource or framework.NewSweepResource are read and recorded,
+// and API operations that may modify a resource are refused (see guard).
+// Only one listing may be in progress at a time.
+func StartListing() {
+	listing.mu.Lock()
+	defer listing.mu.Unlock()
+
+	listing.active = true
+	listing.listed = nil
+}
+
+// StopListing switches sweepers back to deleting resources and returns the resources recorded since StartListing.
+func StopListing() []Listed {
+	listing.mu.Lock()
+	defer listing.mu.Unlock()
+
+	listed := listing.listed
+	listing.active = false
+	listing.listed = nil
+
+	return listed
+}
+
+// Listing returns whether sweepers are listing existing resources instead of deleting them.
+func Listing() bool {
+	listing.mu.Lock()
+	defer listing.mu.Unlock()
+
+	return listing.active
+}
+
+// Record records an existing resource while listing.
+func Record(v Listed) {
+	listing.mu.Lock()
+	defer listing.mu.Unlock()
+
+	if listing.active {
+		listing.listed = append(listing.listed, v)
+	}
+}
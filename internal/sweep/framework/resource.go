@@ -5,6 +5,9 @@ package framework
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
 	"strings"
 	"time"
 
@@ -16,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -73,8 +78,23 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 		ctx = tflog.SetField(ctx, attr.path, attr.value)
 	}
 
+	if filter.Listing() {
+		return sr.list(ctx, metadata.TypeName, resource, state)
+	}
+
+	if err := sr.filter(ctx, resource, state); err != nil {
+		var skipped *filter.SkippedError
+		if errors.As(err, &skipped) {
+			log.Printf("[INFO] Skipping sweep of %s (%s): %s", metadata.TypeName, sr, skipped.Reason)
+		}
+
+		return err
+	}
+
 	tflog.Info(ctx, "Sweeping resource")
 
+	ctx = filter.NewDeleteContext(ctx)
+
 	err = tfresource.Retry(ctx, timeout, func() *retry.RetryError {
 		err := deleteResource(ctx, state, resource)
 
@@ -99,6 +119,114 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// AppliesFilters marks the Sweepable as applying the sweep filter options.
+func (*sweepResource) AppliesFilters() {}
+
+// filter returns a filter.SkippedError if the sweep filter options prevent the resource being deleted.
+// If the options filter on resource attributes, the resource is read first.
+func (sr *sweepResource) filter(ctx context.Context, resource fwresource.Resource, state tfsdk.State) error {
+	options, err := filter.Current()
+
+	if err != nil {
+		return err
+	}
+
+	if !options.Active() {
+		return nil
+	}
+
+	if !options.Filtered() {
+		return options.Evaluate(filter.Resource{})
+	}
+
+	ctx = tftags.NewContext(ctx, sr.meta.DefaultTagsConfig, sr.meta.IgnoreTagsConfig)
+
+	state, err = readResource(ctx, state, resource)
+
+	if err != nil {
+		return err
+	}
+
+	return options.Evaluate(filter.Describe(ctx, sr.meta, attributeGetter(ctx, state)))
+}
+
+// list reads and records the resource instead of deleting it.
+func (sr *sweepResource) list(ctx context.Context, typeName string, resource fwresource.Resource, state tfsdk.State) error {
+	ctx = tftags.NewContext(ctx, sr.meta.DefaultTagsConfig, sr.meta.IgnoreTagsConfig)
+
+	state, err := readResource(ctx, state, resource)
+
+	if err != nil {
+		return err
+	}
+
+	filter.Record(filter.Listed{
+		Get:      attributeGetter(ctx, state),
+		TypeName: typeName,
+	})
+
+	return &filter.SkippedError{Reason: "listed"}
+}
+
+// readResource reads the resource, returning a filter.SkippedError if it no longer exists.
+func readResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) (tfsdk.State, error) {
+	response := fwresource.ReadResponse{State: state}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+	if response.Diagnostics.HasError() {
+		return state, fwdiag.DiagnosticsError(response.Diagnostics)
+	}
+
+	if response.State.Raw.IsNull() {
+		return state, &filter.SkippedError{Reason: "not found"}
+	}
+
+	return response.State, nil
+}
+
+// attributeGetter returns a filter.AttributeGetter for the resource's string and map of string attributes.
+func attributeGetter(ctx context.Context, state tfsdk.State) filter.AttributeGetter {
+	return func(name string) any {
+		attribute, ok := state.Schema.GetAttributes()[name]
+
+		if !ok {
+			return nil
+		}
+
+		switch t := attribute.GetType().TerraformType(ctx); {
+		case t.Is(tftypes.String):
+			var v *string
+
+			if diags := state.GetAttribute(ctx, path.Root(name), &v); diags.HasError() || v == nil {
+				return nil
+			}
+
+			return *v
+		case t.Is(tftypes.Map{ElementType: tftypes.String}):
+			var v map[string]string
+
+			if diags := state.GetAttribute(ctx, path.Root(name), &v); diags.HasError() {
+				return nil
+			}
+
+			return v
+		}
+
+		return nil
+	}
+}
+
+// String returns a description of the resource to be swept from its identifying attributes.
+func (sr *sweepResource) String() string {
+	parts := make([]string, 0, len(sr.attributes))
+
+	for _, attr := range sr.attributes {
+		parts = append(parts, fmt.Sprintf("%s=%v", attr.path, attr.value))
+	}
+
+	return strings.Join(parts, ",")
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Command importblocks writes a Terraform `import` block for every existing resource listed by the sweepers
+// of the selected resource types in a Region. Nothing is deleted.
+//
+//	go run -tags=sweep ./internal/sweep/importblocks -region=us-west-2 -types=aws_vpc,aws_subnet -out=imports.tf
+//
+// Sweepers are registered only when built with the `sweep` build tag.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+var (
+	region = flag.String("region", "", "Region in which to list existing resources")
+	types  = flag.String("types", "", "Comma-separated list of resource types to list, defaults to all types with a registered sweeper")
+	out    = flag.String("out", "imports.tf", "File to write Terraform import blocks to")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tgo run -tags=sweep ./internal/sweep/importblocks -region=<region> [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	if *region == "" || strings.Contains(*region, ",") {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run() error {
+	ctx := sweep.Context(*region)
+	sweep.ServicePackages = provider.ServicePackages(ctx)
+
+	typeNames := sweep.ImportableTypeNames()
+	if len(typeNames) == 0 {
+		return errors.New("no sweepers registered, build with -tags=sweep")
+	}
+
+	if v := *types; v != "" {
+		typeNames = strings.Split(v, ",")
+	}
+
+	client, err := sweep.SharedRegionalSweepClient(ctx, *region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	var errs []error
+	var identities []sweep.ImportIdentity
+	seen := make(map[sweep.ImportIdentity]bool)
+	for _, typeName := range typeNames {
+		v, err := sweep.ListImportIdentities(ctx, client, typeName)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		// Sweepers may list resources of types other than their own, which are also listed by those types' sweepers.
+		n := 0
+		for _, v := range v {
+			if !seen[v] {
+				seen[v] = true
+				identities = append(identities, v)
+				n++
+			}
+		}
+
+		log.Printf("%s: %d existing resources", typeName, n)
+	}
+
+	f, err := os.Create(*out)
+	if err != nil {
+		return fmt.Errorf("creating %s: %w", *out, err)
+	}
+	defer f.Close()
+
+	if err := sweep.WriteImportBlocks(f, identities); err != nil {
+		return fmt.Errorf("writing %s: %w", *out, err)
+	}
+
+	log.Printf("wrote %d import blocks to %s", len(identities), *out)
+
+	return errors.Join(errs...)
+}
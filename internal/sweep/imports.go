@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ImportIDFunc returns the import ID of an existing resource from its attribute values, or "" if it isn't known.
+type ImportIDFunc func(get filter.AttributeGetter) string
+
+// importIDFuncs is the registry of import ID functions.
+var importIDFuncs = make(map[string]ImportIDFunc)
+
+// RegisterImportID registers the function that returns the import ID of existing resources of the resource type with the specified name.
+// Plugin SDK resources whose importer is schema.ImportStatePassthroughContext are imported by ID and need no function.
+func RegisterImportID(name string, f ImportIDFunc) {
+	if _, ok := importIDFuncs[name]; ok {
+		log.Fatalf("[ERR] Error registering import ID function (%s): already registered", name)
+	}
+
+	importIDFuncs[name] = f
+}
+
+// ImportIDAttribute returns an ImportIDFunc that returns the value of the attribute with the specified name.
+func ImportIDAttribute(name string) ImportIDFunc {
+	return func(get filter.AttributeGetter) string {
+		v, _ := get(name).(string)
+
+		return v
+	}
+}
+
+// ImportIdentity identifies an existing resource to be imported.
+type ImportIdentity struct {
+	ID       string // Import ID
+	TypeName string // Resource type, e.g. "aws_vpc"
+}
+
+// ImportableTypeNames returns the names of all resource types whose existing resources can be listed for import.
+func ImportableTypeNames() []string {
+	names := make([]string, 0, len(sweepers))
+	for name := range sweepers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// ListImportIdentities returns the import identities of all existing resources listed by the specified type's sweeper
+// in the client's Region. The sweeper is run in listing mode (see filter.StartListing), so nothing is deleted.
+// Identities are returned for resources of any type listed using sweep.NewSweepResource or framework.NewSweepResource.
+// Resources whose import ID isn't known are skipped with a warning.
+func ListImportIdentities(ctx context.Context, client *conns.AWSClient, typeName string) ([]ImportIdentity, error) {
+	return listImportIdentities(ctx, client, sweepers, typeName)
+}
+
+func listImportIdentities(ctx context.Context, client *conns.AWSClient, registry map[string]*sweeper, typeName string) ([]ImportIdentity, error) {
+	sweeper, ok := registry[typeName]
+	if !ok {
+		return nil, fmt.Errorf("listing %s: no sweeper registered", typeName)
+	}
+
+	filter.StartListing()
+	err := sweeper.list(ctx, client)
+	listed := filter.StopListing()
+
+	if err != nil {
+		return nil, fmt.Errorf("listing %s (%s): %w", typeName, client.Region, err)
+	}
+
+	identities := make([]ImportIdentity, 0, len(listed))
+	for _, v := range listed {
+		identity, err := importIdentity(typeName, v)
+
+		if err != nil {
+			log.Printf("[WARN] Skipping import of resource listed by %s sweeper (%s): %s", typeName, client.Region, err)
+			continue
+		}
+
+		identities = append(identities, identity)
+	}
+
+	return identities, nil
+}
+
+// list runs the sweeper in listing mode.
+func (s *sweeper) list(ctx context.Context, client *conns.AWSClient) error {
+	if s.legacy != nil {
+		// Refused API calls are expected from sweepers that modify resources directly.
+		if err := s.legacy(client.Region); err != nil && !filter.IsSkipped(err) {
+			return err
+		}
+
+		return nil
+	}
+
+	sweepResources, err := s.f(ctx, client)
+
+	if SkipSweepError(err) || awsv2.SkipSweepError(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range sweepResources {
+		if err := deleteSweepable(ctx, v, ThrottlingRetryTimeout); err != nil && !filter.IsSkipped(err) {
+			log.Printf("[WARN] Listing %s (%s): %s", s.name, sweepableID(v), err)
+		}
+	}
+
+	return nil
+}
+
+// importIdentity returns the import identity of a resource listed by the specified type's sweeper.
+func importIdentity(sweeperName string, v filter.Listed) (ImportIdentity, error) {
+	typeName := v.TypeName
+
+	if v.Resource != nil {
+		var err error
+
+		if typeName, err = sdkResourceTypeName(v.Resource, sweeperName); err != nil {
+			return ImportIdentity{}, err
+		}
+	}
+
+	var id string
+	if f, ok := importIDFuncs[typeName]; ok {
+		id = f(v.Get)
+	} else if v.Resource != nil && isImportStatePassthrough(v.Resource) {
+		id, _ = v.Get(names.AttrID).(string)
+	} else {
+		return ImportIdentity{}, fmt.Errorf("%s: no import ID function registered", typeName)
+	}
+
+	if id == "" {
+		return ImportIdentity{}, fmt.Errorf("%s: import ID unknown", typeName)
+	}
+
+	return ImportIdentity{
+		ID:       id,
+		TypeName: typeName,
+	}, nil
+}
+
+// isImportStatePassthrough returns whether the Plugin SDK resource is imported using its ID alone.
+func isImportStatePassthrough(r *schema.Resource) bool {
+	switch importer := r.Importer; {
+	case importer == nil:
+		return false
+	case importer.StateContext != nil:
+		return funcPointer(importer.StateContext) == funcPointer(schema.ImportStatePassthroughContext)
+	case importer.State != nil:
+		return funcPointer(importer.State) == funcPointer(schema.ImportStatePassthrough) //nolint:staticcheck // Still used by some resources.
+	default:
+		return false
+	}
+}
+
+// sdkResourceTypeNames returns the type names of the Plugin SDK resources of ServicePackages, keyed by their Read function (see readFuncPointer).
+// It is a variable so that tests can replace it.
+var sdkResourceTypeNames = sync.OnceValue(func() map[uintptr][]string {
+	ctx := context.Background()
+	typeNames := make(map[uintptr][]string)
+
+	for _, sp := range ServicePackages {
+		for _, v := range sp.SDKResources(ctx) {
+			if p := readFuncPointer(v.Factory()); p != 0 {
+				typeNames[p] = append(typeNames[p], v.TypeName)
+			}
+		}
+	}
+
+	return typeNames
+})
+
+// sdkResourceTypeName returns the type name of a Plugin SDK resource listed by the specified type's sweeper.
+// The schema.Resource is matched to the resources of ServicePackages by its Read function.
+func sdkResourceTypeName(r *schema.Resource, sweeperName string) (string, error) {
+	typeNames := sdkResourceTypeNames()[readFuncPointer(r)]
+
+	for _, v := range typeNames {
+		if v == sweeperName {
+			return v, nil
+		}
+	}
+
+	switch len(typeNames) {
+	case 0:
+		return "", fmt.Errorf("resource type unknown")
+	case 1:
+		return typeNames[0], nil
+	default:
+		return "", fmt.Errorf("resource type ambiguous: %s", strings.Join(typeNames, ", "))
+	}
+}
+
+// readFuncPointer returns the code pointer of the Plugin SDK resource's Read function, or 0 if it has none.
+func readFuncPointer(r *schema.Resource) uintptr {
+	switch {
+	case r.ReadWithoutTimeout != nil:
+		return funcPointer(r.ReadWithoutTimeout)
+	case r.ReadContext != nil:
+		return funcPointer(r.ReadContext)
+	case r.Read != nil: //nolint:staticcheck // Still used by some resources.
+		return funcPointer(r.Read) //nolint:staticcheck // Still used by some resources.
+	default:
+		return 0
+	}
+}
+
+func funcPointer(f any) uintptr {
+	return reflect.ValueOf(f).Pointer()
+}
+
+var invalidIdentifierCharsRegexp = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// WriteImportBlocks writes a Terraform `import` block for each identity.
+// Each block's resource address is derived from the import ID.
+// The output can be used with `terraform plan -generate-config-out=<file>` to generate resource configuration.
+func WriteImportBlocks(w io.Writer, identities []ImportIdentity) error {
+	seen := make(map[string]bool)
+
+	for _, v := range identities {
+		name := invalidIdentifierCharsRegexp.ReplaceAllString(strings.ToLower(v.ID), "_")
+		name = strings.Trim(name, "_")
+		if name == "" || name[0] < 'a' || name[0] > 'z' {
+			name = "r_" + name
+		}
+
+		base := v.TypeName + "." + name
+		address := base
+		for n := 2; seen[address]; n++ {
+			address = base + "_" + strconv.Itoa(n)
+		}
+		seen[address] = true
+
+		if _, err := fmt.Fprintf(w, "import {\n  to = %s\n  id = %s\n}\n\n", address, hclQuote(v.ID)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hclQuote returns a quoted HCL string literal for s, escaping template sequences.
+func hclQuote(s string) string {
+	s = strconv.Quote(s)
+	s = strings.ReplaceAll(s, "${", "$${")
+	s = strings.ReplaceAll(s, "%{", "%%{")
+
+	return s
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func TestWriteImportBlocks(t *testing.T) {
+	t.Parallel()
+
+	identities := []sweep.ImportIdentity{
+		{TypeName: "aws_vpc", ID: "vpc-0123456789abcdef0"},
+		{TypeName: "aws_sqs_queue", ID: "https://sqs.us-west-2.amazonaws.com/123456789012/Queue"},
+		{TypeName: "aws_sqs_queue", ID: "https://sqs.us-west-2.amazonaws.com/123456789012/queue"},
+		{TypeName: "aws_example", ID: "123,${abc}"},
+	}
+	want := `import {
+  to = aws_vpc.vpc-0123456789abcdef0
+  id = "vpc-0123456789abcdef0"
+}
+
+import {
+  to = aws_sqs_queue.https_sqs_us-west-2_amazonaws_com_123456789012_queue
+  id = "https://sqs.us-west-2.amazonaws.com/123456789012/Queue"
+}
+
+import {
+  to = aws_sqs_queue.https_sqs_us-west-2_amazonaws_com_123456789012_queue_2
+  id = "https://sqs.us-west-2.amazonaws.com/123456789012/queue"
+}
+
+import {
+  to = aws_example.r_123_abc
+  id = "123,$${abc}"
+}
+
+`
+
+	var b strings.Builder
+	if err := sweep.WriteImportBlocks(&b, identities); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := b.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+)
+
+// SweeperFn lists the existing resources of a single resource type in a client's Region,
+// returning a Sweepable for each.
+// It must not delete or otherwise modify any resource.
+type SweeperFn func(context.Context, *conns.AWSClient) ([]Sweepable, error)
+
+type sweeper struct {
+	dependencies []string
+	f            SweeperFn
+	legacy       func(region string) error
+	name         string
+}
+
+// sweepers is the registry of all sweepers.
+// Sweepers registered via AddTestSweepers have a legacy function, which both lists and deletes resources, instead of f.
+var sweepers = make(map[string]*sweeper)
+
+func register(s *sweeper) {
+	if _, ok := sweepers[s.name]; ok {
+		log.Fatalf("[ERR] Error registering sweeper (%s): already registered", s.name)
+	}
+
+	sweepers[s.name] = s
+}
+
+// AddTestSweepers registers a sweeper that both lists and deletes resources for the resource type with the specified name.
+// The sweeper is added to the Terraform Plugin Testing sweeper framework and to the dependency graph used by RunSweepers.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	sweeper := &sweeper{
+		dependencies: s.Dependencies,
+		legacy:       s.F,
+		name:         name,
+	}
+
+	register(sweeper)
+
+	resource.AddTestSweepers(name, &resource.Sweeper{
+		Name:         s.Name,
+		F:            sweeper.legacy,
+		Dependencies: s.Dependencies,
+	})
+}
+
+// Register registers a sweeper for the resource type with the specified name.
+// Resources listed by f are deleted using SweepOrchestrator.
+func Register(name string, f SweeperFn, dependencies ...string) {
+	register(&sweeper{
+		dependencies: dependencies,
+		f:            f,
+		name:         name,
+	})
+
+	resource.AddTestSweepers(name, &resource.Sweeper{
+		Name: name,
+		F: func(region string) error {
+			ctx := Context(region)
+			client, err := SharedRegionalSweepClient(ctx, region)
+			if err != nil {
+				return fmt.Errorf("getting client: %w", err)
+			}
+
+			sweepResources, err := f(ctx, client)
+
+			if SkipSweepError(err) || awsv2.SkipSweepError(err) {
+				log.Printf("[WARN] Skipping %s sweep for %s: %s", name, region, err)
+				return nil
+			}
+
+			if err != nil {
+				return fmt.Errorf("listing %s (%s): %w", name, region, err)
+			}
+
+			if err := SweepOrchestrator(ctx, sweepResources); err != nil {
+				return fmt.Errorf("sweeping %s (%s): %w", name, region, err)
+			}
+
+			return nil
+		},
+		Dependencies: dependencies,
+	})
+}
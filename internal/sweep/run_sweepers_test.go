@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build sweep
+// +build sweep
+
+package sweep_test
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+var (
+	flagGraphRegions     = flag.String("sweep-graph-region", "", "Comma-separated list of Regions in which to sweep resources in dependency order")
+	flagGraphRun         = flag.String("sweep-graph-run", "", "Comma-separated list of resource types to sweep, defaults to all registered")
+	flagGraphConcurrency = flag.Int("sweep-graph-concurrency", 10, "Maximum number of resources deleted at once")
+	flagGraphSummary     = flag.String("sweep-graph-summary", "", "File to write the JSON sweep summary to")
+)
+
+// TestSweeperLevelsLegacyDependencies verifies that sweepers registered via AddTestSweepers,
+// such as those for network interfaces and security groups, take part in the dependency graph.
+func TestSweeperLevelsLegacyDependencies(t *testing.T) {
+	levels, err := sweep.SweeperLevels([]string{"aws_vpc"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	depths := make(map[string]int)
+	for i, level := range levels {
+		for _, typeName := range level {
+			depths[typeName] = i
+		}
+	}
+
+	for _, v := range [][2]string{
+		{"aws_instance", "aws_network_interface"},
+		{"aws_network_interface", "aws_subnet"},
+		{"aws_security_group", "aws_vpc"},
+		{"aws_route_table", "aws_vpc"},
+		{"aws_subnet", "aws_vpc"},
+	} {
+		before, after := v[0], v[1]
+
+		if _, ok := depths[before]; !ok {
+			t.Errorf("%s not swept", before)
+			continue
+		}
+
+		if depths[before] >= depths[after] {
+			t.Errorf("%s (level %d) not swept before %s (level %d)", before, depths[before], after, depths[after])
+		}
+	}
+}
+
+// TestRunSweepers sweeps resources one level of the sweeper dependency graph at a time,
+// so that, for example, all ENIs are deleted before any subnets and all subnets before any VPCs.
+//
+//	go test ./internal/sweep -v -tags=sweep -run=TestRunSweepers -timeout 360m -sweep-graph-region=us-west-2 -sweep-graph-summary=sweep.json
+func TestRunSweepers(t *testing.T) {
+	if *flagGraphRegions == "" {
+		t.Skip("-sweep-graph-region not set")
+	}
+
+	var typeNames []string
+	if v := *flagGraphRun; v != "" {
+		typeNames = strings.Split(v, ",")
+	}
+
+	var summaries []*sweep.SweepSummary
+	for _, region := range strings.Split(*flagGraphRegions, ",") {
+		ctx := sweep.Context(region)
+		client, err := sweep.SharedRegionalSweepClient(ctx, region)
+		if err != nil {
+			t.Fatalf("getting client: %s", err)
+		}
+
+		summary, err := sweep.RunSweepers(ctx, client, typeNames, *flagGraphConcurrency)
+		if err != nil {
+			t.Fatalf("sweeping %s: %s", region, err)
+		}
+
+		for _, v := range summary.Failed {
+			t.Errorf("%s %s (%s): %s", region, v.TypeName, v.ID, v.Reason)
+		}
+
+		t.Log(summary)
+		summaries = append(summaries, summary)
+	}
+
+	if *flagGraphSummary == "" {
+		return
+	}
+
+	b, err := json.MarshalIndent(summaries, "", "  ")
+	if err != nil {
+		t.Fatalf("encoding summary: %s", err)
+	}
+
+	if err := os.WriteFile(*flagGraphSummary, b, 0644); err != nil { //nolint:gomnd
+		t.Fatalf("writing %s: %s", *flagGraphSummary, err)
+	}
+}
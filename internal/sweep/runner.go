@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+)
+
+// SweepResult records the outcome of sweeping a single resource,
+// or of listing or sweeping all of a resource type's resources if ID is empty.
+type SweepResult struct {
+	TypeName string `json:"type_name"`
+	ID       string `json:"id,omitempty"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// SweepSummary summarizes a sweep of a single Region.
+type SweepSummary struct {
+	Region  string        `json:"region"`
+	Deleted []SweepResult `json:"deleted"`
+	Skipped []SweepResult `json:"skipped"`
+	Failed  []SweepResult `json:"failed"`
+
+	mu sync.Mutex
+}
+
+func (s *SweepSummary) deleted(typeName, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Deleted = append(s.Deleted, SweepResult{TypeName: typeName, ID: id})
+}
+
+func (s *SweepSummary) skipped(typeName, id, reason string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Skipped = append(s.Skipped, SweepResult{TypeName: typeName, ID: id, Reason: reason})
+}
+
+func (s *SweepSummary) failed(typeName, id string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Failed = append(s.Failed, SweepResult{TypeName: typeName, ID: id, Reason: err.Error()})
+}
+
+// String returns a one-line description of the summary.
+func (s *SweepSummary) String() string {
+	return fmt.Sprintf("%s: %d deleted, %d skipped, %d failed", s.Region, len(s.Deleted), len(s.Skipped), len(s.Failed))
+}
+
+// RunSweepers sweeps the specified resource types, and the types that must be swept before them,
+// in the client's Region. If no types are specified then all registered types are swept.
+// Types are swept in dependency order, one level of the dependency graph at a time,
+// with no more than concurrency resources being deleted at once.
+// Errors sweeping individual resources are recorded in the returned summary.
+func RunSweepers(ctx context.Context, client *conns.AWSClient, typeNames []string, concurrency int) (*SweepSummary, error) {
+	return runSweepers(ctx, client, sweepers, typeNames, concurrency)
+}
+
+func runSweepers(ctx context.Context, client *conns.AWSClient, registry map[string]*sweeper, typeNames []string, concurrency int) (*SweepSummary, error) {
+	levels, err := sweeperLevels(registry, typeNames)
+	if err != nil {
+		return nil, err
+	}
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	summary := &SweepSummary{
+		Region: client.Region,
+	}
+	semaphore := make(chan struct{}, concurrency)
+
+	for _, level := range levels {
+		var wg sync.WaitGroup
+
+		for _, typeName := range level {
+			sweeper := registry[typeName]
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				sweeper.sweep(ctx, client, semaphore, summary)
+			}()
+		}
+
+		wg.Wait()
+	}
+
+	return summary, nil
+}
+
+// sweep lists and deletes all resources of the sweeper's type, recording the outcomes.
+func (s *sweeper) sweep(ctx context.Context, client *conns.AWSClient, semaphore chan struct{}, summary *SweepSummary) {
+	if s.legacy != nil {
+		s.sweepLegacy(client, semaphore, summary)
+		return
+	}
+
+	sweepResources, err := s.f(ctx, client)
+
+	if SkipSweepError(err) || awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping %s sweep for %s: %s", s.name, client.Region, err)
+		summary.skipped(s.name, "", err.Error())
+		return
+	}
+
+	if err != nil {
+		summary.failed(s.name, "", fmt.Errorf("listing: %w", err))
+		return
+	}
+
+	var wg sync.WaitGroup
+
+	for _, sweepResource := range sweepResources {
+		sweepResource := sweepResource
+
+		id := sweepableID(sweepResource)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			if err := deleteSweepable(ctx, sweepResource, ThrottlingRetryTimeout); err != nil {
+				var skipped *filter.SkippedError
+				if errors.As(err, &skipped) {
+					summary.skipped(s.name, id, skipped.Reason)
+					return
+				}
+
+				summary.failed(s.name, id, err)
+				return
+			}
+
+			summary.deleted(s.name, id)
+		}()
+	}
+
+	wg.Wait()
+}
+
+// sweepableID returns a description of the Sweepable's resource, if any.
+func sweepableID(v any) string {
+	if v, ok := v.(fmt.Stringer); ok {
+		return v.String()
+	}
+
+	return ""
+}
+
+// sweepLegacy runs a sweeper registered via AddTestSweepers.
+// Such sweepers delete resources themselves, so outcomes are recorded for the resource type as a whole
+// and the sweeper counts against concurrency as a single deletion.
+// While any filter option is set, only resources deleted via sweep.NewSweepResource or framework.NewSweepResource
+// are deleted; the client's guards refuse any other API operation that may modify a resource (see filter.NewDeleteContext).
+func (s *sweeper) sweepLegacy(client *conns.AWSClient, semaphore chan struct{}, summary *SweepSummary) {
+	semaphore <- struct{}{}
+	defer func() { <-semaphore }()
+
+	if err := s.legacy(client.Region); err != nil {
+		var skipped *filter.SkippedError
+		if errors.As(err, &skipped) {
+			summary.skipped(s.name, "", skipped.Reason)
+			return
+		}
+
+		summary.failed(s.name, "", err)
+		return
+	}
+
+	summary.deleted(s.name, "")
+}
+
+// sweeperLevels returns the specified resource types, and the types that must be swept before them,
+// grouped by depth in the dependency graph.
+// Types in a level depend only on types in earlier levels.
+func sweeperLevels(registry map[string]*sweeper, typeNames []string) ([][]string, error) {
+	g := depgraph.New()
+
+	for name := range registry {
+		g.AddNode(name)
+	}
+
+	for name, sweeper := range registry {
+		for _, dependency := range sweeper.dependencies {
+			if !g.HasNode(dependency) {
+				return nil, fmt.Errorf("sweeper %s dependency %s is not registered", name, dependency)
+			}
+
+			if err := g.AddDependency(name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	selected := make(map[string]bool)
+	if len(typeNames) == 0 {
+		for name := range registry {
+			selected[name] = true
+		}
+	} else {
+		for _, name := range typeNames {
+			if !g.HasNode(name) {
+				return nil, fmt.Errorf("sweeper %s is not registered", name)
+			}
+
+			dependencies, err := g.DependenciesOf(name)
+			if err != nil {
+				return nil, err
+			}
+
+			selected[name] = true
+			for _, dependency := range dependencies {
+				selected[dependency] = true
+			}
+		}
+	}
+
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	depths := make(map[string]int)
+	var levels [][]string
+
+	for _, name := range order {
+		dependencies, err := g.DirectDependenciesOf(name)
+		if err != nil {
+			return nil, err
+		}
+
+		depth := 0
+		for _, dependency := range dependencies {
+			if v := depths[dependency] + 1; v > depth {
+				depth = v
+			}
+		}
+		depths[name] = depth
+
+		if !selected[name] {
+			continue
+		}
+
+		for len(levels) <= depth {
+			levels = append(levels, nil)
+		}
+		levels[depth] = append(levels[depth], name)
+	}
+
+	// Unselected types can leave gaps.
+	result := make([][]string, 0, len(levels))
+	for _, level := range levels {
+		if len(level) > 0 {
+			sort.Strings(level)
+			result = append(result, level)
+		}
+	}
+
+	return result, nil
+}
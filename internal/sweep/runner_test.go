@@ -0,0 +1,411 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestSweeperLevels(t *testing.T) {
+	t.Parallel()
+
+	registry := map[string]*sweeper{
+		"aws_vpc":                {name: "aws_vpc", dependencies: []string{"aws_subnet", "aws_internet_gateway"}},
+		"aws_subnet":             {name: "aws_subnet", dependencies: []string{"aws_network_interface"}},
+		"aws_internet_gateway":   {name: "aws_internet_gateway"},
+		"aws_network_interface":  {name: "aws_network_interface", dependencies: []string{"aws_legacy"}},
+		"aws_legacy":             {name: "aws_legacy", legacy: func(string) error { return nil }},
+		"aws_dynamodb_table":     {name: "aws_dynamodb_table"},
+		"aws_security_group":     {name: "aws_security_group", dependencies: []string{"aws_network_interface"}},
+		"aws_vpc_endpoint":       {name: "aws_vpc_endpoint"},
+		"aws_vpc_endpoint_route": {name: "aws_vpc_endpoint_route", dependencies: []string{"aws_vpc_endpoint"}},
+	}
+
+	testCases := []struct {
+		Name        string
+		TypeNames   []string
+		Expected    [][]string
+		ExpectError bool
+	}{
+		{
+			Name: "all",
+			Expected: [][]string{
+				{"aws_dynamodb_table", "aws_internet_gateway", "aws_legacy", "aws_vpc_endpoint"},
+				{"aws_network_interface", "aws_vpc_endpoint_route"},
+				{"aws_security_group", "aws_subnet"},
+				{"aws_vpc"},
+			},
+		},
+		{
+			Name:      "selected",
+			TypeNames: []string{"aws_vpc"},
+			Expected: [][]string{
+				{"aws_internet_gateway", "aws_legacy"},
+				{"aws_network_interface"},
+				{"aws_subnet"},
+				{"aws_vpc"},
+			},
+		},
+		{
+			Name:      "no dependencies",
+			TypeNames: []string{"aws_dynamodb_table"},
+			Expected: [][]string{
+				{"aws_dynamodb_table"},
+			},
+		},
+		{
+			Name:        "not registered",
+			TypeNames:   []string{"aws_unknown"},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := sweeperLevels(registry, testCase.TypeNames)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestSweeperLevelsUnregisteredDependency(t *testing.T) {
+	t.Parallel()
+
+	registry := map[string]*sweeper{
+		"aws_subnet": {name: "aws_subnet", dependencies: []string{"aws_unknown"}},
+	}
+
+	if _, err := sweeperLevels(registry, nil); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+type testSweepable struct {
+	err     error
+	id      string
+	deleted func(string)
+}
+
+func (s testSweepable) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	if s.err != nil {
+		return s.err
+	}
+
+	s.deleted(s.id)
+
+	return nil
+}
+
+func (s testSweepable) String() string {
+	return s.id
+}
+
+func TestRunSweepersInOrder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	var order []string
+	deleted := func(id string) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		order = append(order, id)
+	}
+
+	list := func(sweepables ...Sweepable) SweeperFn {
+		return func(context.Context, *conns.AWSClient) ([]Sweepable, error) {
+			return sweepables, nil
+		}
+	}
+
+	registry := map[string]*sweeper{
+		"aws_vpc": {
+			name:         "aws_vpc",
+			dependencies: []string{"aws_subnet"},
+			f: list(
+				testSweepable{id: "vpc-1", deleted: deleted},
+				testSweepable{id: "vpc-2", deleted: deleted},
+			),
+		},
+		"aws_subnet": {
+			name:         "aws_subnet",
+			dependencies: []string{"aws_network_interface"},
+			f: list(
+				testSweepable{id: "subnet-1", deleted: deleted},
+				testSweepable{id: "subnet-2", err: errors.New("DependencyViolation"), deleted: deleted},
+			),
+		},
+		"aws_network_interface": {
+			name: "aws_network_interface",
+			legacy: func(region string) error {
+				deleted("eni-1")
+				deleted("eni-2")
+				deleted("eni-3")
+
+				return nil
+			},
+		},
+		"aws_flow_log": {
+			name: "aws_flow_log",
+			f: func(context.Context, *conns.AWSClient) ([]Sweepable, error) {
+				return nil, errors.New("AccessDenied")
+			},
+		},
+		"aws_ec2_host": {
+			name: "aws_ec2_host",
+			f: func(context.Context, *conns.AWSClient) ([]Sweepable, error) {
+				return nil, awserr.New("UnsupportedOperation", "", nil)
+			},
+		},
+	}
+
+	summary, err := runSweepers(ctx, &conns.AWSClient{Region: "us-west-2"}, registry, nil, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := summary.String(), "us-west-2: 4 deleted, 1 skipped, 2 failed"; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+
+	// ENIs are deleted before subnets, which are deleted before VPCs.
+	levels := map[byte]int{'e': 0, 's': 1, 'v': 2}
+	for i := 1; i < len(order); i++ {
+		if levels[order[i-1][0]] > levels[order[i][0]] {
+			t.Errorf("%s deleted before %s", order[i-1], order[i])
+		}
+	}
+
+	failed := make([]string, 0, len(summary.Failed))
+	for _, v := range summary.Failed {
+		failed = append(failed, v.TypeName+"/"+v.ID)
+	}
+	sort.Strings(failed)
+
+	if expected := []string{"aws_flow_log/", "aws_subnet/subnet-2"}; !reflect.DeepEqual(failed, expected) {
+		t.Errorf("got failed %v, expected %v", failed, expected)
+	}
+}
+
+type testFilterableSweepable struct {
+	testSweepable
+}
+
+func (testFilterableSweepable) AppliesFilters() {}
+
+func TestRunSweepersFilterOptions(t *testing.T) { //nolint:paralleltest // Replaces filterOptions
+	ctx := context.Background()
+
+	filterOptions = func() (*filter.Options, error) {
+		return &filter.Options{DryRun: true}, nil
+	}
+	t.Cleanup(func() {
+		filterOptions = filter.Current
+	})
+
+	var deleted []string
+	var legacyRan bool
+	registry := map[string]*sweeper{
+		"aws_vpc": {
+			name:         "aws_vpc",
+			dependencies: []string{"aws_network_interface"},
+			f: func(context.Context, *conns.AWSClient) ([]Sweepable, error) {
+				return []Sweepable{
+					// Doesn't apply filter options.
+					testSweepable{id: "vpc-1", deleted: func(id string) { deleted = append(deleted, id) }},
+					// Applies filter options.
+					testFilterableSweepable{testSweepable{id: "vpc-2", err: &filter.SkippedError{Reason: "dry run"}}},
+				}, nil
+			},
+		},
+		// Legacy sweepers run, but the client's guards refuse their direct API calls.
+		"aws_network_interface": {
+			name: "aws_network_interface",
+			legacy: func(region string) error {
+				legacyRan = true
+
+				return fmt.Errorf("deleting EC2 Network Interface (eni-1): %w", &filter.SkippedError{Reason: "EC2 DeleteNetworkInterface does not apply TF_AWS_SWEEP_* options"})
+			},
+		},
+	}
+
+	summary, err := runSweepers(ctx, &conns.AWSClient{Region: "us-west-2"}, registry, nil, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := summary.String(), "us-west-2: 0 deleted, 3 skipped, 0 failed"; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+
+	if len(deleted) > 0 {
+		t.Errorf("deleted %v", deleted)
+	}
+
+	if !legacyRan {
+		t.Error("legacy sweeper not run")
+	}
+}
+
+func TestListImportIdentities(t *testing.T) { //nolint:paralleltest // Lists resources
+	ctx := context.Background()
+
+	// Each resource type's Read function identifies it.
+	readVPC := func(context.Context, *schema.ResourceData, any) diag.Diagnostics { return nil }
+	readExample := func(context.Context, *schema.ResourceData, any) diag.Diagnostics { return nil }
+	readRoute := func(context.Context, *schema.ResourceData, any) diag.Diagnostics { return nil }
+	customImport := func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		return []*schema.ResourceData{d}, nil
+	}
+	nameSchema := map[string]*schema.Schema{
+		names.AttrName: {Type: schema.TypeString, Optional: true},
+	}
+
+	vpc := &schema.Resource{
+		ReadWithoutTimeout: readVPC,
+		Importer:           &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
+		Schema:             nameSchema,
+	}
+	// Not imported by ID and no import ID function registered.
+	example := &schema.Resource{
+		ReadWithoutTimeout: readExample,
+		Importer:           &schema.ResourceImporter{StateContext: customImport},
+		Schema:             nameSchema,
+	}
+	// Not imported by ID, but an import ID function is registered.
+	route := &schema.Resource{
+		ReadWithoutTimeout: readRoute,
+		Importer:           &schema.ResourceImporter{StateContext: customImport},
+		Schema:             nameSchema,
+	}
+
+	oldTypeNames := sdkResourceTypeNames
+	sdkResourceTypeNames = func() map[uintptr][]string {
+		return map[uintptr][]string{
+			funcPointer(readVPC):     {"aws_vpc"},
+			funcPointer(readExample): {"aws_example"},
+			funcPointer(readRoute):   {"aws_route"},
+		}
+	}
+	importIDFuncs["aws_route"] = func(get filter.AttributeGetter) string {
+		return get(names.AttrName).(string) + "_" + get(names.AttrID).(string)
+	}
+	t.Cleanup(func() {
+		sdkResourceTypeNames = oldTypeNames
+		delete(importIDFuncs, "aws_route")
+	})
+
+	client := &conns.AWSClient{Region: "us-west-2"}
+	sweepResource := func(r *schema.Resource, id, name string) Sweepable {
+		d := r.Data(nil)
+		d.SetId(id)
+		d.Set(names.AttrName, name)
+
+		return NewSweepResource(r, d, client)
+	}
+	list := func(sweepables ...Sweepable) SweeperFn {
+		return func(context.Context, *conns.AWSClient) ([]Sweepable, error) {
+			return sweepables, nil
+		}
+	}
+
+	registry := map[string]*sweeper{
+		"aws_vpc": {
+			name: "aws_vpc",
+			f: list(
+				sweepResource(vpc, "vpc-1", "a"),
+				sweepResource(vpc, "vpc-2", "b"),
+				testSweepable{id: "vpc-3", err: errors.New("not listed")},
+			),
+		},
+		"aws_example": {
+			name: "aws_example",
+			f:    list(sweepResource(example, "example-1", "c")),
+		},
+		// Legacy sweepers' resources are listed, and their direct API calls refused.
+		"aws_route_table": {
+			name: "aws_route_table",
+			legacy: func(string) error {
+				if err := SweepOrchestrator(ctx, []Sweepable{sweepResource(route, "rtb-1", "d")}); err != nil {
+					return err
+				}
+
+				return fmt.Errorf("deleting EC2 Route Table (rtb-1): %w", &filter.SkippedError{Reason: "EC2 DeleteRouteTable does not apply TF_AWS_SWEEP_* options"})
+			},
+		},
+	}
+
+	testCases := []struct {
+		TypeName string
+		Expected []ImportIdentity
+	}{
+		{
+			TypeName: "aws_vpc",
+			Expected: []ImportIdentity{{ID: "vpc-1", TypeName: "aws_vpc"}, {ID: "vpc-2", TypeName: "aws_vpc"}},
+		},
+		{
+			TypeName: "aws_example",
+			Expected: []ImportIdentity{},
+		},
+		{
+			TypeName: "aws_route_table",
+			Expected: []ImportIdentity{{ID: "d_rtb-1", TypeName: "aws_route"}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		got, err := listImportIdentities(ctx, client, registry, testCase.TypeName)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", testCase.TypeName, err)
+		}
+
+		sort.Slice(got, func(i, j int) bool { return got[i].ID < got[j].ID })
+
+		if !reflect.DeepEqual(got, testCase.Expected) {
+			t.Errorf("%s: got %v, expected %v", testCase.TypeName, got, testCase.Expected)
+		}
+	}
+
+	if filter.Listing() {
+		t.Error("still listing")
+	}
+
+	if _, err := listImportIdentities(ctx, client, registry, "aws_subnet"); err == nil {
+		t.Error("expected error")
+	}
+}
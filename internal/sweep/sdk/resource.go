@@ -5,6 +5,8 @@ package sdk
 
 import (
 	"context"
+	"errors"
+	"log"
 	"strings"
 	"time"
 
@@ -14,7 +16,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -32,7 +37,23 @@ func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *c
 }
 
 func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
-	ctx = tflog.SetField(ctx, "id", sr.d.Id())
+	id := sr.d.Id()
+	ctx = tflog.SetField(ctx, "id", id)
+
+	if filter.Listing() {
+		return sr.list(ctx)
+	}
+
+	if err := sr.filter(ctx); err != nil {
+		var skipped *filter.SkippedError
+		if errors.As(err, &skipped) {
+			log.Printf("[INFO] Skipping sweep of resource (%s): %s", id, skipped.Reason)
+		}
+
+		return err
+	}
+
+	ctx = filter.NewDeleteContext(ctx)
 
 	err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
 		err := deleteResource(ctx, sr.resource, sr.d, sr.meta)
@@ -58,6 +79,82 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// AppliesFilters marks the Sweepable as applying the sweep filter options.
+func (*sweepResource) AppliesFilters() {}
+
+// filter returns a filter.SkippedError if the sweep filter options prevent the resource being deleted.
+// If the options filter on resource attributes, the resource is read first.
+func (sr *sweepResource) filter(ctx context.Context) error {
+	options, err := filter.Current()
+
+	if err != nil {
+		return err
+	}
+
+	if !options.Active() {
+		return nil
+	}
+
+	if !options.Filtered() {
+		return options.Evaluate(filter.Resource{})
+	}
+
+	ctx = tftags.NewContext(ctx, sr.meta.DefaultTagsConfig, sr.meta.IgnoreTagsConfig)
+
+	if err := sr.read(ctx); err != nil {
+		return err
+	}
+
+	return options.Evaluate(filter.Describe(ctx, sr.meta, sr.get))
+}
+
+// list reads and records the resource instead of deleting it.
+func (sr *sweepResource) list(ctx context.Context) error {
+	ctx = tftags.NewContext(ctx, sr.meta.DefaultTagsConfig, sr.meta.IgnoreTagsConfig)
+
+	if err := sr.read(ctx); err != nil {
+		return err
+	}
+
+	filter.Record(filter.Listed{
+		Get:      sr.get,
+		Resource: sr.resource,
+	})
+
+	return &filter.SkippedError{Reason: "listed"}
+}
+
+// read reads the resource, returning a filter.SkippedError if it no longer exists.
+func (sr *sweepResource) read(ctx context.Context) error {
+	if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+		return err
+	}
+
+	if sr.d.Id() == "" {
+		return &filter.SkippedError{Reason: "not found"}
+	}
+
+	return nil
+}
+
+// get returns the value of the resource's attribute with the specified name.
+func (sr *sweepResource) get(name string) any {
+	if name == names.AttrID {
+		return sr.d.Id()
+	}
+
+	if _, ok := sr.resource.SchemaMap()[name]; !ok {
+		return nil
+	}
+
+	return sr.d.Get(name)
+}
+
+// String returns a description of the resource to be swept.
+func (sr *sweepResource) String() string {
+	return sr.d.Id()
+}
+
 func deleteResource(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient) error {
 	if resource.DeleteContext != nil || resource.DeleteWithoutTimeout != nil {
 		var diags diag.Diagnostics
@@ -74,9 +171,21 @@ func deleteResource(ctx context.Context, resource *schema.Resource, d *schema.Re
 	return resource.Delete(d, meta)
 }
 
+// DeleteResource deletes the resource unless the sweep filter options prevent it, in which case a filter.SkippedError is returned.
+//
 // Deprecated: Create a list of Sweepables and pass them to SweepOrchestrator instead
 func DeleteResource(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient) error {
-	return deleteResource(ctx, resource, d, meta)
+	sr := NewSweepResource(resource, d, meta)
+
+	if filter.Listing() {
+		return sr.list(ctx)
+	}
+
+	if err := sr.filter(ctx); err != nil {
+		return err
+	}
+
+	return deleteResource(filter.NewDeleteContext(ctx), resource, d, meta)
 }
 
 func ReadResource(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient) error {
@@ -11,10 +11,14 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv1"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -59,30 +63,37 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	}
 	meta.ServicePackages = servicePackageMap
 
+	// Refuse API operations that would modify resources not selected by the TF_AWS_SWEEP_* options.
 	conf := &conns.Config{
-		Region:           region,
-		SuppressDebugLog: true,
+		APIOptions:            []func(*middleware.Stack) error{filter.SDKv2Middleware()},
+		Region:                region,
+		SDKv1ValidateHandlers: []request.NamedHandler{filter.SDKv1Handler()},
+		SuppressDebugLog:      true,
 	}
 
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
-		conf.AssumeRole.RoleARN = role
+		assumeRole := awsbase.AssumeRole{
+			RoleARN: role,
+		}
 
-		conf.AssumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
+		assumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
 		if v := os.Getenv(envvar.AssumeRoleDuration); v != "" {
 			d, err := strconv.Atoi(v)
 			if err != nil {
 				return nil, fmt.Errorf("environment variable %s: %w", envvar.AssumeRoleDuration, err)
 			}
-			conf.AssumeRole.Duration = time.Duration(d) * time.Second
+			assumeRole.Duration = time.Duration(d) * time.Second
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleExternalID); v != "" {
-			conf.AssumeRole.ExternalID = v
+			assumeRole.ExternalID = v
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleSessionName); v != "" {
-			conf.AssumeRole.SessionName = v
+			assumeRole.SessionName = v
 		}
+
+		conf.AssumeRole = []awsbase.AssumeRole{assumeRole}
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -101,6 +112,44 @@ type Sweepable interface {
 	Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error
 }
 
+// Filterable is implemented by Sweepables that apply the TF_AWS_SWEEP_* filter options (see filter.Current)
+// before deleting their resource.
+type Filterable interface {
+	AppliesFilters()
+}
+
+// deleteSweepable deletes the Sweepable's resource.
+// While any filter option is set, resources of Sweepables that don't apply the options are never deleted.
+// Sweepables returned by sweep.NewSweepResource and framework.NewSweepResource apply the options.
+func deleteSweepable(ctx context.Context, sweepable Sweepable, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	if _, ok := sweepable.(Filterable); !ok {
+		if err := refuseUnfiltered(); err != nil {
+			return err
+		}
+	}
+
+	return sweepable.Delete(ctx, timeout, optFns...)
+}
+
+// filterOptions returns the sweep filter options. It is a variable so that tests can replace it.
+var filterOptions = filter.Current
+
+// refuseUnfiltered returns a filter.SkippedError if any filter option is set or resources are being listed.
+// It guards Sweepables that can't apply the options.
+func refuseUnfiltered() error {
+	options, err := filterOptions()
+
+	if err != nil {
+		return err
+	}
+
+	if options.Active() || filter.Listing() {
+		return &filter.SkippedError{Reason: "sweeper does not support TF_AWS_SWEEP_* options"}
+	}
+
+	return nil
+}
+
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
 	var g multierror.Group
 
@@ -108,7 +157,14 @@ func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tf
 		sweepable := sweepable
 
 		g.Go(func() error {
-			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+			err := deleteSweepable(ctx, sweepable, ThrottlingRetryTimeout, optFns...)
+
+			// Resources excluded by TF_AWS_SWEEP_* filters are not errors.
+			if filter.IsSkipped(err) {
+				return nil
+			}
+
+			return err
 		})
 	}
 
@@ -25,6 +25,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/autoscalingplans"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/backup"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/batch"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/bedrock"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/budgets"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloud9"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
@@ -130,6 +131,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/scheduler"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/schemas"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/securitylake"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ses"
@@ -147,6 +149,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/vpclattice"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/waf"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
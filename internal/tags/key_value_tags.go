@@ -32,7 +32,9 @@ const (
 
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
-	Tags KeyValueTags
+	// Precedence is DefaultTagsPrecedenceProvider if resource tags must not override default tags.
+	Precedence string
+	Tags       KeyValueTags
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// DefaultTagsPrecedenceResource indicates that resource tags override default tags with the same key.
+	DefaultTagsPrecedenceResource = "resource"
+	// DefaultTagsPrecedenceProvider indicates that resource tags must not override default tags with the same key.
+	DefaultTagsPrecedenceProvider = "provider"
+)
+
+// DefaultTagsPrecedences returns the valid values for the default tags precedence.
+func DefaultTagsPrecedences() []string {
+	return []string{
+		DefaultTagsPrecedenceResource,
+		DefaultTagsPrecedenceProvider,
+	}
+}
+
+// PolicyConfig contains rules, typically mirroring an AWS Organizations tag policy,
+// that the tags of all resources must comply with.
+type PolicyConfig struct {
+	// AllowedValues maps tag keys to the only values allowed for those keys.
+	AllowedValues map[string][]string
+	// EnforceKeyCase requires tag keys that match a key in RequiredKeys or AllowedValues,
+	// ignoring case, to match it exactly.
+	EnforceKeyCase bool
+	// RequiredKeys are the keys of the tags that every resource must have.
+	RequiredKeys []string
+}
+
+// ValidateTags returns an error for each way in which the specified resource-level tags,
+// merged with the specified provider-level default tags, don't comply with the provider's tag configuration.
+// The tags must be wholly known.
+func ValidateTags(defaultConfig *DefaultConfig, policyConfig *PolicyConfig, tags KeyValueTags) []error {
+	var errs []error
+
+	if defaultConfig != nil && defaultConfig.Precedence == DefaultTagsPrecedenceProvider {
+		defaultTags, resourceTags := defaultConfig.Tags.Map(), tags.Map()
+
+		for _, k := range sortedKeys(resourceTags) {
+			if v, ok := defaultTags[k]; ok && v != resourceTags[k] {
+				errs = append(errs, fmt.Errorf("tag %q overrides the provider default tag value %q, which default_tags precedence %q does not allow", k, v, DefaultTagsPrecedenceProvider))
+			}
+		}
+	}
+
+	if policyConfig == nil {
+		return errs
+	}
+
+	allTags := defaultConfig.MergeTags(tags).Map()
+
+	if policyConfig.EnforceKeyCase {
+		policyKeys := make(map[string]string)
+		for _, k := range policyConfig.RequiredKeys {
+			policyKeys[strings.ToLower(k)] = k
+		}
+		for k := range policyConfig.AllowedValues {
+			policyKeys[strings.ToLower(k)] = k
+		}
+
+		for _, k := range sortedKeys(allTags) {
+			if v, ok := policyKeys[strings.ToLower(k)]; ok && v != k {
+				errs = append(errs, fmt.Errorf("tag key %q must be written as %q", k, v))
+			}
+		}
+	}
+
+	for _, k := range policyConfig.RequiredKeys {
+		if _, ok := allTags[k]; !ok {
+			errs = append(errs, fmt.Errorf("missing required tag %q", k))
+		}
+	}
+
+	for _, k := range sortedKeys(policyConfig.AllowedValues) {
+		v, ok := allTags[k]
+
+		if !ok {
+			continue
+		}
+
+		if allowedValues := policyConfig.AllowedValues[k]; !stringInSlice(v, allowedValues) {
+			errs = append(errs, fmt.Errorf("tag %q value %q is not one of the allowed values: %s", k, v, strings.Join(allowedValues, ", ")))
+		}
+	}
+
+	return errs
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func stringInSlice(s string, list []string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+)
+
+func TestValidateTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policyConfig := &PolicyConfig{
+		AllowedValues: map[string][]string{
+			"Environment": {"dev", "prod"},
+		},
+		EnforceKeyCase: true,
+		RequiredKeys:   []string{"CostCenter", "Environment"},
+	}
+
+	testCases := []struct {
+		name           string
+		defaultConfig  *DefaultConfig
+		policyConfig   *PolicyConfig
+		tags           KeyValueTags
+		expectedErrors []string
+	}{
+		{
+			name: "no configuration",
+			tags: New(ctx, map[string]string{"key1": "value1"}),
+		},
+		{
+			name: "resource precedence override",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{"Owner": "platform"}),
+			},
+			tags: New(ctx, map[string]string{"Owner": "team"}),
+		},
+		{
+			name: "provider precedence override",
+			defaultConfig: &DefaultConfig{
+				Precedence: DefaultTagsPrecedenceProvider,
+				Tags:       New(ctx, map[string]string{"Owner": "platform"}),
+			},
+			tags: New(ctx, map[string]string{"Owner": "team", "Name": "test"}),
+			expectedErrors: []string{
+				`tag "Owner" overrides the provider default tag value "platform", which default_tags precedence "provider" does not allow`,
+			},
+		},
+		{
+			name: "provider precedence same value",
+			defaultConfig: &DefaultConfig{
+				Precedence: DefaultTagsPrecedenceProvider,
+				Tags:       New(ctx, map[string]string{"Owner": "platform"}),
+			},
+			tags: New(ctx, map[string]string{"Owner": "platform"}),
+		},
+		{
+			name:         "policy compliant",
+			policyConfig: policyConfig,
+			tags:         New(ctx, map[string]string{"CostCenter": "1234", "Environment": "dev", "Name": "test"}),
+		},
+		{
+			name: "policy compliant with default tags",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{"CostCenter": "1234"}),
+			},
+			policyConfig: policyConfig,
+			tags:         New(ctx, map[string]string{"Environment": "prod"}),
+		},
+		{
+			name:         "policy missing required tags",
+			policyConfig: policyConfig,
+			tags:         New(ctx, map[string]string{"Name": "test"}),
+			expectedErrors: []string{
+				`missing required tag "CostCenter"`,
+				`missing required tag "Environment"`,
+			},
+		},
+		{
+			name:         "policy disallowed value",
+			policyConfig: policyConfig,
+			tags:         New(ctx, map[string]string{"CostCenter": "1234", "Environment": "test"}),
+			expectedErrors: []string{
+				`tag "Environment" value "test" is not one of the allowed values: dev, prod`,
+			},
+		},
+		{
+			name:         "policy key case",
+			policyConfig: policyConfig,
+			tags:         New(ctx, map[string]string{"costcenter": "1234", "Environment": "dev"}),
+			expectedErrors: []string{
+				`tag key "costcenter" must be written as "CostCenter"`,
+				`missing required tag "CostCenter"`,
+			},
+		},
+		{
+			name: "policy key case not enforced",
+			policyConfig: &PolicyConfig{
+				RequiredKeys: []string{"Environment"},
+			},
+			tags: New(ctx, map[string]string{"environment": "dev", "Environment": "dev"}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			errs := ValidateTags(testCase.defaultConfig, testCase.policyConfig, testCase.tags)
+
+			if got, expected := len(errs), len(testCase.expectedErrors); got != expected {
+				t.Fatalf("got %d errors (%v), expected %d", got, errs, expected)
+			}
+
+			for i, err := range errs {
+				if got, expected := err.Error(), testCase.expectedErrors[i]; got != expected {
+					t.Errorf("got error %q, expected %q", got, expected)
+				}
+			}
+		})
+	}
+}
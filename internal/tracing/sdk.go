@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// SDKv1Handler returns a request handler that records each AWS SDK for Go v1 API operation on completion.
+func (t *Tracer) SDKv1Handler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "tftracing.Record",
+		Fn: func(r *request.Request) {
+			span := Span{
+				StartTime:  r.Time,
+				DurationMS: time.Since(r.Time).Milliseconds(),
+				Service:    r.ClientInfo.ServiceID,
+				Operation:  r.Operation.Name,
+				Region:     aws_sdkv1.StringValue(r.Config.Region),
+				Attempts:   r.RetryCount + 1,
+				Retries:    r.RetryCount,
+				RequestID:  r.RequestID,
+			}
+
+			if r.HTTPResponse != nil {
+				span.HTTPStatusCode = r.HTTPResponse.StatusCode
+			}
+
+			var awsErr awserr.Error
+			if errors.As(r.Error, &awsErr) {
+				span.ErrorCode = awsErr.Code()
+			}
+
+			if err := t.Record(r.Context(), span); err != nil {
+				log.Printf("[WARN] Recording AWS API call trace: %s", err)
+			}
+		},
+	}
+}
+
+// SDKv2Middleware returns an API option that records each AWS SDK for Go v2 API operation on completion.
+func (t *Tracer) SDKv2Middleware() func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("tftracing.Record", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			start := time.Now()
+
+			out, metadata, err := next.HandleInitialize(ctx, in)
+
+			span := Span{
+				StartTime:  start,
+				DurationMS: time.Since(start).Milliseconds(),
+				Service:    awsmiddleware.GetServiceID(ctx),
+				Operation:  awsmiddleware.GetOperationName(ctx),
+				Region:     awsmiddleware.GetRegion(ctx),
+				Attempts:   1,
+			}
+
+			if results, ok := retry_sdkv2.GetAttemptResults(metadata); ok && len(results.Results) > 0 {
+				span.Attempts = len(results.Results)
+				span.Retries = span.Attempts - 1
+			}
+
+			if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+				span.RequestID = v
+			}
+
+			if v, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok {
+				span.HTTPStatusCode = v.StatusCode
+			}
+
+			var apiErr smithy.APIError
+			if errors.As(err, &apiErr) {
+				span.ErrorCode = apiErr.ErrorCode()
+			}
+
+			if err := t.Record(ctx, span); err != nil {
+				log.Printf("[WARN] Recording AWS API call trace: %s", err)
+			}
+
+			return out, metadata, err
+		}), middleware.Before)
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tracing records every AWS API call made by the provider as a line of JSON.
+package tracing
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// flushInterval is the interval at which buffered Spans are written.
+const flushInterval = time.Second
+
+// Span records a single AWS API call, including any retries.
+type Span struct {
+	StartTime       time.Time `json:"start_time"`
+	DurationMS      int64     `json:"duration_ms"`
+	Service         string    `json:"service"`
+	Operation       string    `json:"operation"`
+	Region          string    `json:"region,omitempty"`
+	ResourceAddress string    `json:"resource_address,omitempty"`
+	ResourceType    string    `json:"resource_type,omitempty"`
+	ResourceID      string    `json:"resource_id,omitempty"`
+	Phase           string    `json:"phase,omitempty"`
+	Attempts        int       `json:"attempts"`
+	Retries         int       `json:"retries"`
+	HTTPStatusCode  int       `json:"http_status_code,omitempty"`
+	RequestID       string    `json:"request_id,omitempty"`
+	ErrorCode       string    `json:"error_code,omitempty"`
+}
+
+// Tracer writes Spans as JSON Lines.
+// Spans are buffered and written at least every second, and when the Tracer is closed.
+// A Tracer is safe for concurrent use.
+type Tracer struct {
+	mu     sync.Mutex
+	w      *bufio.Writer
+	out    io.Writer // Underlying writer.
+	closed bool
+	done   chan struct{} // Closed by Close to stop periodic flushes.
+}
+
+// New returns a Tracer that writes to w.
+func New(w io.Writer) *Tracer {
+	return newTracer(w, flushInterval)
+}
+
+func newTracer(w io.Writer, interval time.Duration) *Tracer {
+	t := &Tracer{
+		w:    bufio.NewWriter(w),
+		out:  w,
+		done: make(chan struct{}),
+	}
+
+	go t.flushEvery(interval)
+
+	return t
+}
+
+// NewFileTracer returns a Tracer that appends to the specified file, creating it if necessary.
+// Only whole lines are written so that multiple provider processes can share a file.
+// The file is synced and closed by Close.
+func NewFileTracer(path string) (*Tracer, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) //nolint:gomnd
+
+	if err != nil {
+		return nil, err
+	}
+
+	return New(f), nil
+}
+
+// Record writes the Span, adding the resource information from Context.
+func (t *Tracer) Record(ctx context.Context, span Span) error {
+	if v, ok := FromContext(ctx); ok {
+		span.Phase = v.Phase
+		span.ResourceAddress = v.Address()
+		span.ResourceID = v.ResourceID
+		span.ResourceType = v.ResourceType
+	}
+
+	b, err := json.Marshal(span)
+
+	if err != nil {
+		return err
+	}
+
+	b = append(b, '\n')
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closed {
+		return errTracerClosed
+	}
+
+	// Flush any buffered lines first rather than splitting this one across writes.
+	if len(b) > t.w.Available() && t.w.Buffered() > 0 {
+		if err := t.w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	_, err = t.w.Write(b)
+
+	return err
+}
+
+// flushEvery writes buffered Spans at the specified interval until the Tracer is closed.
+// Any error is returned by the next Record.
+func (t *Tracer) flushEvery(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			t.mu.Lock()
+			if !t.closed {
+				_ = t.w.Flush() // Any error is returned by the next Record.
+			}
+			t.mu.Unlock()
+		case <-t.done:
+			return
+		}
+	}
+}
+
+// Close writes any buffered Spans, commits written data to stable storage if the underlying writer is a file
+// and closes the underlying writer if it is an io.Closer.
+// Spans recorded after Close are discarded with an error.
+func (t *Tracer) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closed {
+		return nil
+	}
+	t.closed = true
+	close(t.done)
+
+	var errs []error
+
+	if err := t.w.Flush(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if w, ok := t.out.(interface{ Sync() error }); ok {
+		if err := w.Sync(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if w, ok := t.out.(io.Closer); ok {
+		if err := w.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+var errTracerClosed = errors.New("tracer is closed")
+
+// InContext represents the Terraform resource information kept in Context.
+type InContext struct {
+	IsDataSource bool   // Data source?
+	Phase        string // CRUD phase, e.g. "read"
+	ResourceID   string // Resource ID, if known
+	ResourceType string // Terraform resource or data source type name, e.g. "aws_vpc"
+}
+
+// Address returns the address of the resource instance or data source, e.g. `aws_vpc["vpc-12345678"]` or `data.aws_vpc`.
+// Terraform doesn't send configured resource addresses to providers, so resource instances are keyed by ID.
+// A resource being created has no ID yet and its address is its type name.
+func (v *InContext) Address() string {
+	switch {
+	case v.IsDataSource:
+		return "data." + v.ResourceType
+	case v.ResourceID == "":
+		return v.ResourceType
+	default:
+		return fmt.Sprintf("%s[%q]", v.ResourceType, v.ResourceID)
+	}
+}
+
+type contextKeyType int
+
+var contextKey contextKeyType
+
+// NewContext returns a Context recording the Terraform resource on whose behalf API calls are made.
+func NewContext(ctx context.Context, resourceType, phase, resourceID string) context.Context {
+	v := InContext{
+		Phase:        phase,
+		ResourceID:   resourceID,
+		ResourceType: resourceType,
+	}
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
+// NewDataSourceContext returns a Context recording the Terraform data source on whose behalf API calls are made.
+func NewDataSourceContext(ctx context.Context, typeName string) context.Context {
+	v := InContext{
+		IsDataSource: true,
+		Phase:        "read",
+		ResourceType: typeName,
+	}
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
+// FromContext returns the Terraform resource information kept in Context.
+func FromContext(ctx context.Context) (*InContext, bool) {
+	v, ok := ctx.Value(contextKey).(*InContext)
+	return v, ok
+}
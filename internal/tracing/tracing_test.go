@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTracerRecord(t *testing.T) {
+	t.Parallel()
+
+	var b bytes.Buffer
+	tracer := New(&b)
+	ctx := NewContext(context.Background(), "aws_vpc", "read", "vpc-12345678")
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if err := tracer.Record(ctx, Span{StartTime: start, DurationMS: 25, Service: "EC2", Operation: "DescribeVpcs", Region: "us-west-2", Attempts: 2, Retries: 1, HTTPStatusCode: 200}); err != nil { //lintignore:AWSAT003
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := tracer.Record(NewDataSourceContext(context.Background(), "aws_vpc"), Span{StartTime: start, Service: "EC2", Operation: "DescribeVpcs", Attempts: 1}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := tracer.Record(context.Background(), Span{StartTime: start, Service: "STS", Operation: "GetCallerIdentity", Attempts: 1, ErrorCode: "ExpiredToken"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := tracer.Close(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{
+		`{"start_time":"2024-01-01T00:00:00Z","duration_ms":25,"service":"EC2","operation":"DescribeVpcs","region":"us-west-2","resource_address":"aws_vpc[\"vpc-12345678\"]","resource_type":"aws_vpc","resource_id":"vpc-12345678","phase":"read","attempts":2,"retries":1,"http_status_code":200}`, //lintignore:AWSAT003
+		`{"start_time":"2024-01-01T00:00:00Z","duration_ms":0,"service":"EC2","operation":"DescribeVpcs","resource_address":"data.aws_vpc","resource_type":"aws_vpc","phase":"read","attempts":1,"retries":0}`,
+		`{"start_time":"2024-01-01T00:00:00Z","duration_ms":0,"service":"STS","operation":"GetCallerIdentity","attempts":1,"retries":0,"error_code":"ExpiredToken"}`,
+	}
+
+	if got := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n"); strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("got\n%s\nexpected\n%s", strings.Join(got, "\n"), strings.Join(expected, "\n"))
+	}
+}
+
+func TestFileTracerClose(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+
+	tracer, err := NewFileTracer(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := tracer.Record(ctx, Span{Service: "STS", Operation: "GetCallerIdentity", Attempts: 1}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := tracer.Close(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Buffered Spans are written by Close.
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := string(b), `{"start_time":"0001-01-01T00:00:00Z","duration_ms":0,"service":"STS","operation":"GetCallerIdentity","attempts":1,"retries":0}`+"\n"; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+
+	if err := tracer.Close(); err != nil {
+		t.Errorf("unexpected error closing twice: %s", err)
+	}
+
+	if err := tracer.Record(ctx, Span{Service: "STS", Operation: "GetCallerIdentity"}); err == nil {
+		t.Error("expected error recording after Close")
+	}
+}
+
+func TestTracerFlushInterval(t *testing.T) {
+	t.Parallel()
+
+	var w lockedBuffer
+	tracer := newTracer(&w, 10*time.Millisecond)
+	defer tracer.Close()
+
+	if err := tracer.Record(context.Background(), Span{Service: "STS", Operation: "GetCallerIdentity", Attempts: 1}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Buffered Spans are written without waiting for Close.
+	for deadline := time.Now().Add(10 * time.Second); w.Len() == 0; time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("Span not written")
+		}
+	}
+}
+
+func TestTracerRecordWholeLines(t *testing.T) {
+	t.Parallel()
+
+	var w lockedBuffer
+	tracer := New(&w)
+
+	for i := 0; i < 100; i++ {
+		if err := tracer.Record(context.Background(), Span{Service: "STS", Operation: strings.Repeat("x", i*10), Attempts: 1}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if err := tracer.Close(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Every write to the underlying writer ends with a complete line.
+	for i, v := range w.writes {
+		if !strings.HasSuffix(v, "\n") {
+			t.Errorf("write %d is not a whole number of lines", i)
+		}
+	}
+}
+
+// lockedBuffer is a bytes.Buffer that is safe for concurrent use and records each write.
+type lockedBuffer struct {
+	mu     sync.Mutex
+	b      bytes.Buffer
+	writes []string
+}
+
+func (w *lockedBuffer) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.writes = append(w.writes, string(p))
+
+	return w.b.Write(p)
+}
+
+func (w *lockedBuffer) Len() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.b.Len()
+}
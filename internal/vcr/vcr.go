@@ -0,0 +1,446 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+	"gopkg.in/dnaeon/go-vcr.v3/recorder"
+)
+
+// HTTP fixture modes.
+const (
+	// ModeRecord sends API requests to AWS and records each interaction.
+	ModeRecord = "record"
+	// ModeReplay serves API requests from previously recorded interactions only.
+	ModeReplay = "replay"
+)
+
+func Modes() []string {
+	return []string{
+		ModeRecord,
+		ModeReplay,
+	}
+}
+
+// redactedHeaders are the request headers removed before interactions are saved.
+// They carry AWS Signature Version 4 credentials.
+var redactedHeaders = []string{
+	"Authorization",
+	"X-Amz-Security-Token",
+}
+
+// redactedResponseFields are the response body fields whose values are replaced by redactedValue before interactions are saved.
+// They carry credentials (AWS STS, AWS IAM Identity Center) and secrets (AWS Secrets Manager, AWS KMS), including the results of ephemeral resources.
+var redactedResponseFields = []string{
+	"Plaintext",
+	"SecretAccessKey",
+	"SecretBinary",
+	"SecretString",
+	"SessionToken",
+	"secretAccessKey",
+	"sessionToken",
+}
+
+// redactedValue replaces the values of redactedResponseFields.
+// It is also valid base64 so that blob fields can still be deserialized.
+const redactedValue = "REDACTED"
+
+// redactedHeader marks recorded requests whose response is redacted before being saved.
+// Such interactions are only replayed in ModeReplay.
+const redactedHeader = "X-Vcr-Redacted"
+
+var redactedXMLElementsRegexp = regexp.MustCompile(`<(` + strings.Join(redactedResponseFields, "|") + `)>[^<]*</`)
+
+// Recorder is a go-vcr recorder used as the HTTP transport of both AWS SDKs' API clients.
+type Recorder struct {
+	*recorder.Recorder
+}
+
+// NewRecorder returns a go-vcr recorder with the specified options.
+// Sensitive request headers are removed and sensitive response fields are redacted before interactions are saved.
+// Requests are matched on method, URL and (semantically) body.
+func NewRecorder(ctx context.Context, opts *recorder.Options) (*Recorder, error) {
+	r, err := recorder.NewWithOptions(opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove sensitive HTTP headers.
+	r.AddHook(func(i *cassette.Interaction) error {
+		// The headers are those of the request being sent.
+		i.Request.Headers = i.Request.Headers.Clone()
+
+		for _, v := range redactedHeaders {
+			i.Request.Headers.Del(v)
+		}
+
+		// The response is returned unredacted, so mark the interaction now.
+		if redactResponseBody(ctx, i.Response) != i.Response.Body {
+			i.Request.Headers.Set(redactedHeader, "true")
+		}
+
+		return nil
+	}, recorder.AfterCaptureHook)
+
+	// Redact sensitive response fields.
+	r.AddHook(func(i *cassette.Interaction) error {
+		i.Response.Body = redactResponseBody(ctx, i.Response)
+
+		return nil
+	}, recorder.BeforeSaveHook)
+
+	r.SetMatcher(matcher(ctx, opts.Mode == recorder.ModeReplayOnly))
+
+	return &Recorder{Recorder: r}, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+// A request without a recorded interaction fails with an error that neither AWS SDK retries.
+func (r *Recorder) RoundTrip(request *http.Request) (*http.Response, error) {
+	response, err := r.Recorder.RoundTrip(request)
+
+	if errors.Is(err, cassette.ErrInteractionNotFound) {
+		return nil, &interactionNotFoundError{method: request.Method, url: request.URL.String()}
+	}
+
+	return response, err
+}
+
+// redactResponseBody returns the response body with the values of redactedResponseFields replaced.
+func redactResponseBody(ctx context.Context, response cassette.Response) string {
+	body := response.Body
+
+	// Parameters such as charset are ignored.
+	contentType, _, err := mime.ParseMediaType(response.Headers.Get("Content-Type"))
+	if err != nil {
+		return body
+	}
+
+	switch contentType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		var v interface{}
+
+		if err := json.Unmarshal([]byte(body), &v); err != nil {
+			tflog.Debug(ctx, "Failed to unmarshal response JSON", map[string]interface{}{
+				"error": err,
+			})
+			return body
+		}
+
+		if !redactJSON(v) {
+			return body
+		}
+
+		b, err := json.Marshal(v)
+		if err != nil {
+			return body
+		}
+
+		return string(b)
+
+	case "application/xml", "text/xml":
+		return redactedXMLElementsRegexp.ReplaceAllString(body, "<${1}>"+redactedValue+"</")
+	}
+
+	return body
+}
+
+// redactJSON replaces the values of redactedResponseFields in a JSON value, reporting whether any were replaced.
+func redactJSON(v interface{}) bool {
+	var redacted bool
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if slices.Contains(redactedResponseFields, k) {
+				if e != redactedValue {
+					v[k] = redactedValue
+					redacted = true
+				}
+			} else if redactJSON(e) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, e := range v {
+			if redactJSON(e) {
+				redacted = true
+			}
+		}
+	}
+
+	return redacted
+}
+
+// matcher defines how VCR will match requests to responses.
+// Interactions whose response was redacted are matched only if replayRedacted is true.
+func matcher(ctx context.Context, replayRedacted bool) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		// Default matcher compares method and URL only.
+		if !cassette.DefaultMatcher(r, i) {
+			return false
+		}
+
+		// Redacted credentials and secrets can't be used when recording.
+		if !replayRedacted && i.Headers.Get(redactedHeader) != "" {
+			return false
+		}
+
+		if r.Body == nil {
+			return true
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		r.Body = io.NopCloser(&b)
+		body := b.String()
+		// If body matches identically, we are done.
+		if body == i.Body {
+			return true
+		}
+
+		// Parameters such as charset are ignored.
+		contentType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil {
+			return false
+		}
+
+		// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
+		switch contentType {
+		case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+			// JSON might be the same, but reordered. Try parsing and comparing.
+			var requestJson, cassetteJson interface{}
+
+			if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			return reflect.DeepEqual(requestJson, cassetteJson)
+
+		case "application/x-www-form-urlencoded":
+			// awsQuery and ec2Query parameters might be the same, but reordered. Try parsing and comparing.
+			requestForm, err := url.ParseQuery(body)
+			if err != nil {
+				tflog.Debug(ctx, "Failed to parse request form", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			cassetteForm, err := url.ParseQuery(i.Body)
+			if err != nil {
+				tflog.Debug(ctx, "Failed to parse cassette form", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			return reflect.DeepEqual(requestForm, cassetteForm)
+
+		case "application/xml", "text/xml":
+			// XML might be the same, but formatted differently. Try tokenizing and comparing.
+			requestXml, err := xmlTokens(body)
+			if err != nil {
+				tflog.Debug(ctx, "Failed to tokenize request XML", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			cassetteXml, err := xmlTokens(i.Body)
+			if err != nil {
+				tflog.Debug(ctx, "Failed to tokenize cassette XML", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			return reflect.DeepEqual(requestXml, cassetteXml)
+		}
+
+		return false
+	}
+}
+
+// xmlTokens returns the canonical token stream of an XML document.
+// Whitespace between elements, comments, processing instructions and directives are dropped, and attributes are sorted.
+func xmlTokens(s string) ([]xml.Token, error) {
+	var tokens []xml.Token
+
+	decoder := xml.NewDecoder(strings.NewReader(s))
+
+	for {
+		token, err := decoder.Token()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			token = token.Copy()
+			sort.Slice(token.Attr, func(i, j int) bool {
+				if token.Attr[i].Name.Space != token.Attr[j].Name.Space {
+					return token.Attr[i].Name.Space < token.Attr[j].Name.Space
+				}
+
+				return token.Attr[i].Name.Local < token.Attr[j].Name.Local
+			})
+			tokens = append(tokens, token)
+		case xml.EndElement:
+			tokens = append(tokens, token)
+		case xml.CharData:
+			if v := bytes.TrimSpace(token); len(v) > 0 {
+				tokens = append(tokens, xml.CharData(bytes.Clone(v)))
+			}
+		}
+	}
+
+	return tokens, nil
+}
+
+var (
+	transports   = make(map[string]*transport)
+	transportsMu sync.Mutex
+)
+
+// NewTransport returns an http.RoundTripper serving AWS API calls from the
+// fixtures file for the specified name in directory.
+// In ModeRecord, requests not already in the fixtures file are sent using
+// realTransport and the file is updated after every new interaction, so
+// nothing is lost when the provider process exits.
+// Provider configurations in the same process sharing a fixtures file share a transport.
+func NewTransport(ctx context.Context, directory, name, mode string, realTransport http.RoundTripper) (http.RoundTripper, error) {
+	var recorderMode recorder.Mode
+
+	switch mode {
+	case ModeRecord:
+		recorderMode = recorder.ModeReplayWithNewEpisodes
+	case ModeReplay:
+		recorderMode = recorder.ModeReplayOnly
+	default:
+		return nil, fmt.Errorf("unsupported HTTP fixtures mode: %s", mode)
+	}
+
+	cassetteName := filepath.Join(directory, name)
+
+	transportsMu.Lock()
+	defer transportsMu.Unlock()
+
+	if t, ok := transports[cassetteName]; ok && t.recorder.Mode() == recorderMode {
+		return t, nil
+	}
+
+	r, err := NewRecorder(ctx, &recorder.Options{
+		CassetteName:       cassetteName,
+		Mode:               recorderMode,
+		RealTransport:      realTransport,
+		SkipRequestLatency: true,
+	})
+
+	if err != nil {
+		if errors.Is(err, cassette.ErrCassetteNotFound) {
+			return nil, fmt.Errorf("HTTP fixtures (%s.yaml) not found, record them first: %w", cassetteName, err)
+		}
+
+		return nil, fmt.Errorf("loading HTTP fixtures (%s.yaml): %w", cassetteName, err)
+	}
+
+	t := &transport{
+		record:   mode == ModeRecord,
+		recorder: r,
+	}
+	transports[cassetteName] = t
+
+	return t, nil
+}
+
+type transport struct {
+	mu       sync.Mutex
+	record   bool
+	recorder *Recorder
+}
+
+func (t *transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	response, err := t.recorder.RoundTrip(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if t.record {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+
+		if err := t.recorder.Stop(); err != nil {
+			return nil, fmt.Errorf("saving HTTP fixtures: %w", err)
+		}
+	}
+
+	return response, nil
+}
+
+// interactionNotFoundError is returned when there is no recorded interaction for a request.
+// It reports itself as neither temporary (AWS SDK for Go v1) nor retryable (AWS SDK for Go v2)
+// so that neither AWS SDK retries the request.
+type interactionNotFoundError struct {
+	method string
+	url    string
+}
+
+func (e *interactionNotFoundError) Error() string {
+	return fmt.Sprintf("no recorded HTTP fixture for %s %s", e.method, e.url)
+}
+
+func (e *interactionNotFoundError) Temporary() bool {
+	return false
+}
+
+func (e *interactionNotFoundError) RetryableError() bool {
+	return false
+}
+
+func (e *interactionNotFoundError) Unwrap() error {
+	return cassette.ErrInteractionNotFound
+}
@@ -5,6 +5,7 @@ package verify
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -44,6 +45,11 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 		return nil
 	}
 
+	// Report tags that don't comply with the provider's tag configuration before any API call is made.
+	if errs := tftags.ValidateTags(defaultTagsConfig, meta.(*conns.AWSClient).TagPolicyConfig, resourceTags); len(errs) > 0 {
+		return fmt.Errorf("invalid resource tags: %w", errors.Join(errs...))
+	}
+
 	if diff.HasChange("tags") {
 		_, n := diff.GetChange("tags")
 		newTags := tftags.New(ctx, n.(map[string]interface{}))
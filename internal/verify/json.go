@@ -10,8 +10,10 @@ import (
 	"log"
 	"reflect"
 	"regexp"
+	"sort"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -42,6 +44,39 @@ func SuppressEquivalentPolicyDiffs(k, old, new string, d *schema.ResourceData) b
 	return equivalent
 }
 
+// PolicyStringsEquivalent returns whether two IAM policy documents are
+// semantically equivalent. Policies with the same canonical form (see
+// PolicyCanonicalize) are always equivalent; otherwise the policies are
+// compared as by SuppressEquivalentPolicyDiffs.
+func PolicyStringsEquivalent(s1, s2 string) (bool, error) {
+	c1, err1 := PolicyCanonicalize(s1)
+	c2, err2 := PolicyCanonicalize(s2)
+
+	if err1 == nil && err2 == nil && c1 == c2 {
+		return true, nil
+	}
+
+	return awspolicy.PoliciesAreEquivalent(s1, s2)
+}
+
+// trimPolicy trims whitespace and unwraps a one-length list of JSON, as
+// returned by some AWS APIs for assume-role policies.
+func trimPolicy(s string) string {
+	s = strings.TrimSpace(s)
+
+	if strings.HasPrefix(s, "[") && strings.HasSuffix(s, "]") {
+		s = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(s, "["), "]"))
+	}
+
+	return s
+}
+
+func isEmptyPolicy(s string) bool {
+	s = trimPolicy(s)
+
+	return s == "" || s == "{}"
+}
+
 func SuppressEquivalentJSONDiffs(k, old, new string, d *schema.ResourceData) bool {
 	return JSONStringsEqual(old, new)
 }
@@ -189,3 +224,171 @@ func LegacyPolicyToSet(exist, new string) (string, error) {
 
 	return policyToSet, nil
 }
+
+// PolicyCanonicalize returns a canonical JSON form of an IAM policy document.
+// Empty documents become "{}", single element arrays are collapsed, string
+// lists are de-duplicated and sorted, root user ARN principals become account
+// IDs, statements are sorted and the Version element is first.
+// Policies with identical output are equivalent, but equivalent policies that
+// differ in other ways, e.g. in the case of action names or in the form of a
+// wildcard principal, may not produce identical output.
+func PolicyCanonicalize(policy string) (string, error) {
+	if isEmptyPolicy(policy) {
+		return "{}", nil
+	}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal([]byte(trimPolicy(policy)), &doc); err != nil {
+		return "", fmt.Errorf("policy (%s) is invalid JSON: %w", policy, err)
+	}
+
+	if v, ok := doc["Statement"]; ok {
+		var statements []interface{}
+
+		switch v := v.(type) {
+		case []interface{}:
+			statements = v
+		case map[string]interface{}:
+			statements = []interface{}{v}
+		default:
+			return "", fmt.Errorf("policy (%s) has an invalid Statement element", policy)
+		}
+
+		for _, statement := range statements {
+			if statement, ok := statement.(map[string]interface{}); ok {
+				canonicalizePolicyStatement(statement)
+			}
+		}
+
+		keys := make([]string, len(statements))
+		for i, statement := range statements {
+			b, err := json.Marshal(statement)
+			if err != nil {
+				return "", err
+			}
+			keys[i] = string(b)
+		}
+
+		sort.Sort(byKey{keys: keys, values: statements})
+
+		doc["Statement"] = statements
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	return LegacyPolicyNormalize(string(b))
+}
+
+func canonicalizePolicyStatement(statement map[string]interface{}) {
+	for _, k := range []string{"Action", "NotAction", "Resource", "NotResource"} {
+		if v, ok := statement[k]; ok {
+			statement[k] = canonicalizePolicyStringOrSlice(v)
+		}
+	}
+
+	for _, k := range []string{"Principal", "NotPrincipal"} {
+		if v, ok := statement[k].(map[string]interface{}); ok {
+			for principalType, principals := range v {
+				if principalType == "AWS" {
+					principals = canonicalizePolicyAWSPrincipals(principals)
+				}
+				v[principalType] = canonicalizePolicyStringOrSlice(principals)
+			}
+		}
+	}
+
+	if v, ok := statement["Condition"].(map[string]interface{}); ok {
+		for _, operator := range v {
+			if operator, ok := operator.(map[string]interface{}); ok {
+				for key, values := range operator {
+					operator[key] = canonicalizePolicyStringOrSlice(values)
+				}
+			}
+		}
+	}
+}
+
+// canonicalizePolicyAWSPrincipals replaces root user ARN principals with their
+// account ID, as AWS treats ACCOUNTID and arn:PARTITION:iam::ACCOUNTID:root as the same principal.
+func canonicalizePolicyAWSPrincipals(v interface{}) interface{} {
+	switch v := v.(type) {
+	case string:
+		return canonicalizePolicyAWSPrincipal(v)
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			if item, ok := item.(string); ok {
+				result[i] = canonicalizePolicyAWSPrincipal(item)
+			} else {
+				result[i] = item
+			}
+		}
+		return result
+	default:
+		return v
+	}
+}
+
+func canonicalizePolicyAWSPrincipal(principal string) string {
+	if v, err := arn.Parse(principal); err == nil && v.Service == "iam" && v.Resource == "root" && principalAccountIDRegexp.MatchString(v.AccountID) {
+		return v.AccountID
+	}
+
+	return principal
+}
+
+var principalAccountIDRegexp = regexp.MustCompile(`^[0-9]{12}$`)
+
+// canonicalizePolicyStringOrSlice de-duplicates and sorts a list of strings,
+// collapsing a single element list to a string. Other values are unchanged.
+func canonicalizePolicyStringOrSlice(v interface{}) interface{} {
+	list, ok := v.([]interface{})
+	if !ok {
+		return v
+	}
+
+	seen := make(map[string]struct{}, len(list))
+	var values []string
+
+	for _, item := range list {
+		item, ok := item.(string)
+		if !ok {
+			return v
+		}
+
+		if _, ok := seen[item]; ok {
+			continue
+		}
+
+		seen[item] = struct{}{}
+		values = append(values, item)
+	}
+
+	sort.Strings(values)
+
+	if len(values) == 1 {
+		return values[0]
+	}
+
+	result := make([]interface{}, len(values))
+	for i, value := range values {
+		result[i] = value
+	}
+
+	return result
+}
+
+type byKey struct {
+	keys   []string
+	values []interface{}
+}
+
+func (s byKey) Len() int           { return len(s.keys) }
+func (s byKey) Less(i, j int) bool { return s.keys[i] < s.keys[j] }
+func (s byKey) Swap(i, j int) {
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+	s.values[i], s.values[j] = s.values[j], s.values[i]
+}
@@ -617,6 +617,53 @@ Outputs:
 	}
 }
 
+func TestSuppressEquivalentPolicyDiffs(t *testing.T) {
+	t.Parallel()
+
+	const policy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+
+	testCases := []struct {
+		Name     string
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{
+			Name:     "bothEmpty",
+			Old:      "",
+			New:      " {} ",
+			Suppress: true,
+		},
+		{
+			Name:     "reordered",
+			Old:      policy,
+			New:      `{"Statement":[{"Resource":"*","Action":["s3:GetObject"],"Effect":"Allow"}],"Version":"2012-10-17"}`,
+			Suppress: true,
+		},
+		{
+			Name: "invalidJSON",
+			Old:  policy,
+			New:  `{"Statement":`,
+		},
+		{
+			Name: "different",
+			Old:  policy,
+			New:  `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := SuppressEquivalentPolicyDiffs("policy", tc.Old, tc.New, nil); got != tc.Suppress {
+				t.Errorf("expected %t, got: %t", tc.Suppress, got)
+			}
+		})
+	}
+}
+
 func TestLegacyPolicyNormalize(t *testing.T) {
 	t.Parallel()
 
@@ -799,3 +846,220 @@ func TestLegacyPolicyNormalize(t *testing.T) {
 		})
 	}
 }
+
+func TestPolicyCanonicalize(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Name:     "empty",
+			Input:    "",
+			Expected: "{}",
+		},
+		{
+			Name:     "emptyObject",
+			Input:    " {} ",
+			Expected: "{}",
+		},
+		{
+			Name:     "listWrapped",
+			Input:    `[{"Statement":{"Action":"*","Effect":"Allow","Resource":"*"},"Version":"2012-10-17"}]`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"*","Effect":"Allow","Resource":"*"}]}`,
+		},
+		{
+			Name:     "rootPrincipal",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Principal":{"AWS":"arn:aws-us-gov:iam::123456789012:root"}}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"*","Effect":"Allow","Principal":{"AWS":"123456789012"},"Resource":"*"}]}`,
+		},
+		{
+			Name:     "rolePrincipal",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Principal":{"AWS":"arn:aws:iam::123456789012:role/root"}}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"*","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:role/root"},"Resource":"*"}]}`,
+		},
+		{
+			Name:     "singleStatementObject",
+			Input:    `{"Statement":{"Action":"*","Effect":"Allow","Resource":"*"},"Version":"2012-10-17"}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"*","Effect":"Allow","Resource":"*"}]}`,
+		},
+		{
+			Name: "sortedAndDeduplicated",
+			Input: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["s3:PutObject", "s3:GetObject", "s3:PutObject"],
+      "Resource": ["arn:aws:s3:::bucket/*"],
+      "Principal": {
+        "AWS": ["arn:aws:iam::123456789012:root", "arn:aws:iam::012345678901:root"]
+      },
+      "Condition": {
+        "StringEquals": {
+          "aws:SourceVpce": ["vpce-2", "vpce-1"]
+        }
+      }
+    }
+  ]
+}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":["s3:GetObject","s3:PutObject"],"Condition":{"StringEquals":{"aws:SourceVpce":["vpce-1","vpce-2"]}},"Effect":"Allow","Principal":{"AWS":["012345678901","123456789012"]},"Resource":"arn:aws:s3:::bucket/*"}]}`,
+		},
+		{
+			Name: "statementOrder",
+			Input: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Sid": "B", "Effect": "Deny", "Action": "s3:DeleteObject", "Resource": "*"},
+    {"Sid": "A", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}
+  ]
+}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"s3:DeleteObject","Effect":"Deny","Resource":"*","Sid":"B"},{"Action":"s3:GetObject","Effect":"Allow","Resource":"*","Sid":"A"}]}`,
+		},
+		{
+			Name:  "badJSON",
+			Input: `{"Statement":`,
+			Error: true,
+		},
+		{
+			Name:  "badStatement",
+			Input: `{"Statement":"*"}`,
+			Error: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := PolicyCanonicalize(tc.Input)
+
+			if tc.Error {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("expected no error, got: %s", err)
+			}
+
+			if p != tc.Expected {
+				t.Errorf("expected %s, got: %s", tc.Expected, p)
+			}
+		})
+	}
+}
+
+func TestPolicyStringsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name       string
+		Policy1    string
+		Policy2    string
+		Equivalent bool
+		Error      bool
+	}{
+		{
+			Name:       "bothEmpty",
+			Policy1:    "",
+			Policy2:    "{}",
+			Equivalent: true,
+		},
+		{
+			Name:       "emptyList",
+			Policy1:    "[]",
+			Policy2:    " {} ",
+			Equivalent: true,
+		},
+		{
+			Name:       "emptyAndNotEmpty",
+			Policy1:    "{}",
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "accountIDPrincipal",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":{"AWS":"123456789012"}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":{"AWS":["arn:aws:iam::123456789012:root"]}}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "accountIDPrincipals",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":{"AWS":["123456789012","arn:aws:iam::012345678901:root"]}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":{"AWS":["arn:aws:iam::123456789012:root","012345678901"]}}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "differentAccountIDPrincipal",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":{"AWS":"123456789012"}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":{"AWS":"arn:aws:iam::012345678901:root"}}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "reordered",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`,
+			Policy2:    `{"Statement":{"Resource":["*"],"Action":["s3:PutObject","s3:GetObject"],"Effect":"Allow"},"Version":"2012-10-17"}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "different",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:    "badJSON",
+			Policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2: `{"Statement":`,
+			Error:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			equivalent, err := PolicyStringsEquivalent(tc.Policy1, tc.Policy2)
+
+			if tc.Error {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("expected no error, got: %s", err)
+			}
+
+			if equivalent != tc.Equivalent {
+				t.Errorf("expected %t, got: %t", tc.Equivalent, equivalent)
+			}
+
+			// Policies with the same canonical form must be equivalent.
+			c1, err := PolicyCanonicalize(tc.Policy1)
+			if err != nil {
+				t.Fatalf("canonicalizing policy 1: %s", err)
+			}
+
+			c2, err := PolicyCanonicalize(tc.Policy2)
+			if err != nil {
+				t.Fatalf("canonicalizing policy 2: %s", err)
+			}
+
+			if c1 == c2 && !tc.Equivalent {
+				t.Errorf("expected canonical forms to differ, got: %s", c1)
+			}
+		})
+	}
+}